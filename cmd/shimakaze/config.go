@@ -8,7 +8,14 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/newrelic/go-agent/v3/integrations/nrmongo-v2"
+	"github.com/redis/go-redis/v9"
+	_cache "github.com/rl404/fairy/cache"
+	channelStatsHistoryMongo "github.com/rl404/shimakaze/internal/domain/channel_stats_history/repository/mongo"
+	milestoneMongo "github.com/rl404/shimakaze/internal/domain/milestone/repository/mongo"
+	vtuberMongo "github.com/rl404/shimakaze/internal/domain/vtuber/repository/mongo"
+	vtuberChangeMongo "github.com/rl404/shimakaze/internal/domain/vtuber_change/repository/mongo"
 	"github.com/rl404/shimakaze/internal/utils"
+	"github.com/rl404/shimakaze/pkg/breaker"
 	"github.com/rl404/shimakaze/pkg/cache"
 	"github.com/rl404/shimakaze/pkg/pubsub"
 	"go.mongodb.org/mongo-driver/v2/mongo"
@@ -16,20 +23,25 @@ import (
 )
 
 type config struct {
-	App      appConfig      `envconfig:"APP"`
-	HTTP     httpConfig     `envconfig:"HTTP"`
-	Cache    cacheConfig    `envconfig:"CACHE"`
-	DB       dbConfig       `envconfig:"DB"`
-	PubSub   pubsubConfig   `envconfig:"PUBSUB"`
-	Cron     cronConfig     `envconfig:"CRON"`
-	Log      logConfig      `envconfig:"LOG"`
-	Newrelic newrelicConfig `envconfig:"NEWRELIC"`
-	Youtube  youtubeConfig  `envconfig:"YOUTUBE"`
-	Twitch   twitchConfig   `envconfig:"TWITCH"`
-	Bilibili bilibiliConfig `envconfig:"BILIBILI"`
-	Niconico niconicoConfig `envconfig:"NICONICO"`
-	JWT      jwtConfig      `envconfig:"JWT"`
-	SSO      ssoConfig      `envconfig:"SSO"`
+	App       appConfig       `envconfig:"APP"`
+	HTTP      httpConfig      `envconfig:"HTTP"`
+	Cache     cacheConfig     `envconfig:"CACHE"`
+	DB        dbConfig        `envconfig:"DB"`
+	PubSub    pubsubConfig    `envconfig:"PUBSUB"`
+	Cron      cronConfig      `envconfig:"CRON"`
+	Breaker   breakerConfig   `envconfig:"BREAKER"`
+	Log       logConfig       `envconfig:"LOG"`
+	Newrelic  newrelicConfig  `envconfig:"NEWRELIC"`
+	Youtube   youtubeConfig   `envconfig:"YOUTUBE"`
+	Twitch    twitchConfig    `envconfig:"TWITCH"`
+	Bilibili  bilibiliConfig  `envconfig:"BILIBILI"`
+	Niconico  niconicoConfig  `envconfig:"NICONICO"`
+	JWT       jwtConfig       `envconfig:"JWT"`
+	SSO       ssoConfig       `envconfig:"SSO"`
+	Milestone milestoneConfig `envconfig:"MILESTONE"`
+	Search    searchConfig    `envconfig:"SEARCH"`
+	Similar   similarConfig   `envconfig:"SIMILAR"`
+	Activity  activityConfig  `envconfig:"ACTIVITY"`
 }
 
 type appConfig struct {
@@ -64,11 +76,18 @@ type pubsubConfig struct {
 }
 
 type cronConfig struct {
-	UpdateLimit int `envconfig:"UPDATE_LIMIT" validate:"required,gte=0" mod:"default=10"`
-	FillLimit   int `envconfig:"FILL_LIMIT" validate:"required,gte=0" mod:"default=10"`
-	AgencyAge   int `envconfig:"AGENCY_AGE" validate:"required,gte=0" mod:"default=7"`  // days
-	ActiveAge   int `envconfig:"ACTIVE_AGE" validate:"required,gte=0" mod:"default=1"`  // days
-	RetiredAge  int `envconfig:"RETIRED_AGE" validate:"required,gte=0" mod:"default=7"` // days
+	UpdateLimit   int `envconfig:"UPDATE_LIMIT" validate:"required,gte=0" mod:"default=10"`
+	FillLimit     int `envconfig:"FILL_LIMIT" validate:"required,gte=0" mod:"default=10"`
+	AgencyAge     int `envconfig:"AGENCY_AGE" validate:"required,gte=0" mod:"default=7"`       // days
+	ActiveAge     int `envconfig:"ACTIVE_AGE" validate:"required,gte=0" mod:"default=1"`       // days
+	RetiredAge    int `envconfig:"RETIRED_AGE" validate:"required,gte=0" mod:"default=7"`      // days
+	StatsRawAge   int `envconfig:"STATS_RAW_AGE" validate:"required,gte=0" mod:"default=30"`   // days
+	StatsDailyAge int `envconfig:"STATS_DAILY_AGE" validate:"required,gte=0" mod:"default=12"` // months
+}
+
+type breakerConfig struct {
+	Threshold int           `envconfig:"THRESHOLD" validate:"required,gt=0" mod:"default=5"`
+	Timeout   time.Duration `envconfig:"TIMEOUT" default:"1m" validate:"required,gt=0"`
 }
 
 type logConfig struct {
@@ -83,22 +102,33 @@ type newrelicConfig struct {
 }
 
 type youtubeConfig struct {
-	Keys   []string `envconfig:"KEY"`
-	MaxAge int      `envconfig:"MAX_AGE" validate:"required,gte=0" mod:"default=60"`
+	Keys           []string `envconfig:"KEY"`
+	MaxAge         int      `envconfig:"MAX_AGE" validate:"required,gte=0" mod:"default=60"`
+	RateLimit      int      `envconfig:"RATE_LIMIT" validate:"required,gt=0" mod:"default=10"` // per second
+	WebSubHub      string   `envconfig:"WEBSUB_HUB" validate:"required,url" mod:"default=https://pubsubhubbub.appspot.com/subscribe,no_space"`
+	WebSubCallback string   `envconfig:"WEBSUB_CALLBACK" mod:"no_space"`
+	WebSubSecret   string   `envconfig:"WEBSUB_SECRET" validate:"required_with=WebSubCallback"`
+	WebSubLease    int      `envconfig:"WEBSUB_LEASE" validate:"required,gte=0" mod:"default=432000"` // seconds
 }
 
 type twitchConfig struct {
-	ClientID     string `envconfig:"CLIENT_ID"`
-	ClientSecret string `envconfig:"CLIENT_SECRET"`
-	MaxAge       int    `envconfig:"MAX_AGE" validate:"required,gte=0" mod:"default=60"`
+	ClientID         string `envconfig:"CLIENT_ID"`
+	ClientSecret     string `envconfig:"CLIENT_SECRET"`
+	MaxAge           int    `envconfig:"MAX_AGE" validate:"required,gte=0" mod:"default=60"`
+	ScheduleAge      int    `envconfig:"SCHEDULE_AGE" validate:"required,gt=0" mod:"default=14"` // days
+	RateLimit        int    `envconfig:"RATE_LIMIT" validate:"required,gt=0" mod:"default=10"`   // per second
+	EventSubCallback string `envconfig:"EVENTSUB_CALLBACK" mod:"no_space"`
+	EventSubSecret   string `envconfig:"EVENTSUB_SECRET" validate:"required_with=EventSubCallback,omitempty,gte=10,lte=100"`
 }
 
 type bilibiliConfig struct {
-	MaxAge int `envconfig:"MAX_AGE" validate:"required,gte=0" mod:"default=60"`
+	MaxAge    int `envconfig:"MAX_AGE" validate:"required,gte=0" mod:"default=60"`
+	RateLimit int `envconfig:"RATE_LIMIT" validate:"required,gt=0" mod:"default=2"` // per second
 }
 
 type niconicoConfig struct {
-	MaxAge int `envconfig:"MAX_AGE" validate:"required,gte=0" mod:"default=60"`
+	MaxAge    int `envconfig:"MAX_AGE" validate:"required,gte=0" mod:"default=60"`
+	RateLimit int `envconfig:"RATE_LIMIT" validate:"required,gt=0" mod:"default=2"` // per second
 }
 
 type jwtConfig struct {
@@ -114,9 +144,34 @@ type ssoConfig struct {
 	RedirectURL  string `envconfig:"REDIRECT_URL"`
 }
 
+type milestoneConfig struct {
+	Subscribers []int `envconfig:"SUBSCRIBERS" default:"100000,250000,500000,1000000,2000000,3000000,4000000,5000000" validate:"dive,gt=0"`
+}
+
+type searchConfig struct {
+	RefreshInterval time.Duration `envconfig:"REFRESH_INTERVAL" default:"10m" validate:"required,gt=0"`
+	CheckInterval   time.Duration `envconfig:"CHECK_INTERVAL" default:"30s" validate:"required,gt=0"`
+}
+
+type similarConfig struct {
+	Limit               int     `envconfig:"LIMIT" validate:"required,gt=0" mod:"default=20"`
+	AgencyWeight        float64 `envconfig:"AGENCY_WEIGHT" default:"3" validate:"gte=0"`
+	LanguageWeight      float64 `envconfig:"LANGUAGE_WEIGHT" default:"2" validate:"gte=0"`
+	CreatorWeight       float64 `envconfig:"CREATOR_WEIGHT" default:"2" validate:"gte=0"`
+	DebutWeight         float64 `envconfig:"DEBUT_WEIGHT" default:"1" validate:"gte=0"`
+	StreamingHourWeight float64 `envconfig:"STREAMING_HOUR_WEIGHT" default:"1" validate:"gte=0"`
+	SubscriberWeight    float64 `envconfig:"SUBSCRIBER_WEIGHT" default:"1" validate:"gte=0"`
+}
+
+type activityConfig struct {
+	HiatusAge  int `envconfig:"HIATUS_AGE" validate:"required,gt=0" mod:"default=30"`   // days
+	DormantAge int `envconfig:"DORMANT_AGE" validate:"required,gt=0" mod:"default=180"` // days
+}
+
 const envPath = "../../.env"
 const envPrefix = "SHIMAKAZE"
 const pubsubTopic = "shimakaze-pubsub"
+const milestoneTopic = "shimakaze-milestone"
 
 var cacheType = map[string]cache.CacheType{
 	"nocache":  cache.NOP,
@@ -193,6 +248,76 @@ func newDB(cfg dbConfig) (*mongo.Database, error) {
 	return client.Database(cfg.Name), nil
 }
 
+// createIndexes to create collection indexes.
+// Existing indexes are not recreated.
+func createIndexes(db *mongo.Database, cronCfg cronConfig) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if err := channelStatsHistoryMongo.CreateIndexes(ctx, db, cronCfg.StatsRawAge, cronCfg.StatsDailyAge); err != nil {
+		return err
+	}
+
+	for _, fn := range []func(context.Context, *mongo.Database) error{
+		milestoneMongo.CreateIndexes,
+		vtuberMongo.CreateIndexes,
+		vtuberChangeMongo.CreateIndexes,
+	} {
+		if err := fn(ctx, db); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type breakers struct {
+	wikia    *breaker.Breaker
+	youtube  *breaker.Breaker
+	twitch   *breaker.Breaker
+	bilibili *breaker.Breaker
+	niconico *breaker.Breaker
+	redis    *redis.Client
+}
+
+// newBreakers to create circuit breakers. Redis cache is
+// shared between processes so the breaker state is locked
+// with redis too. No-operation cache does not keep the
+// state so it is kept in-process instead.
+func newBreakers(cacher _cache.Cacher, cacheCfg cacheConfig, cfg breakerConfig) breakers {
+	var b breakers
+	var locker breaker.Locker = breaker.NewLocalLocker()
+	switch cacheCfg.Dialect {
+	case "nocache":
+		cacher = nil
+	case "redis":
+		b.redis = redis.NewClient(&redis.Options{
+			Addr:     cacheCfg.Address,
+			Password: cacheCfg.Password,
+		})
+		locker = breaker.NewRedisLocker(b.redis)
+	}
+
+	b.wikia = breaker.New(cacher, locker, "wikia", cfg.Threshold, cfg.Timeout)
+	b.youtube = breaker.New(cacher, locker, "youtube", cfg.Threshold, cfg.Timeout)
+	b.twitch = breaker.New(cacher, locker, "twitch", cfg.Threshold, cfg.Timeout)
+	b.bilibili = breaker.New(cacher, locker, "bilibili", cfg.Threshold, cfg.Timeout)
+	b.niconico = breaker.New(cacher, locker, "niconico", cfg.Threshold, cfg.Timeout)
+
+	return b
+}
+
+func (b breakers) list() []*breaker.Breaker {
+	return []*breaker.Breaker{b.wikia, b.youtube, b.twitch, b.bilibili, b.niconico}
+}
+
+func (b breakers) close() error {
+	if b.redis == nil {
+		return nil
+	}
+	return b.redis.Close()
+}
+
 func generateGoogleServiceAccountJSON(filename, value string) (string, error) {
 	if err := os.WriteFile(filename, []byte(value), 0644); err != nil {
 		return "", err
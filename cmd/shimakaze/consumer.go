@@ -15,24 +15,36 @@ import (
 	agencyRepository "github.com/rl404/shimakaze/internal/domain/agency/repository"
 	agencyMongo "github.com/rl404/shimakaze/internal/domain/agency/repository/mongo"
 	bilibiliRepository "github.com/rl404/shimakaze/internal/domain/bilibili/repository"
+	bilibiliBreaker "github.com/rl404/shimakaze/internal/domain/bilibili/repository/breaker"
 	bilibiliClient "github.com/rl404/shimakaze/internal/domain/bilibili/repository/client"
 	channelStatsHistoryRepository "github.com/rl404/shimakaze/internal/domain/channel_stats_history/repository"
 	channelStatsHistoryMongo "github.com/rl404/shimakaze/internal/domain/channel_stats_history/repository/mongo"
 	languageRepository "github.com/rl404/shimakaze/internal/domain/language/repository"
 	languageMongo "github.com/rl404/shimakaze/internal/domain/language/repository/mongo"
+	milestoneRepository "github.com/rl404/shimakaze/internal/domain/milestone/repository"
+	milestoneMongo "github.com/rl404/shimakaze/internal/domain/milestone/repository/mongo"
 	niconicoRepository "github.com/rl404/shimakaze/internal/domain/niconico/repository"
+	niconicoBreaker "github.com/rl404/shimakaze/internal/domain/niconico/repository/breaker"
 	niconicoClient "github.com/rl404/shimakaze/internal/domain/niconico/repository/client"
 	nonVtuberRepository "github.com/rl404/shimakaze/internal/domain/non_vtuber/repository"
 	nonVtuberMongo "github.com/rl404/shimakaze/internal/domain/non_vtuber/repository/mongo"
 	publisherRepository "github.com/rl404/shimakaze/internal/domain/publisher/repository"
 	publisherPubsub "github.com/rl404/shimakaze/internal/domain/publisher/repository/pubsub"
+	searchRepository "github.com/rl404/shimakaze/internal/domain/search/repository"
+	searchCache "github.com/rl404/shimakaze/internal/domain/search/repository/cache"
+	searchMemory "github.com/rl404/shimakaze/internal/domain/search/repository/memory"
 	twitchRepository "github.com/rl404/shimakaze/internal/domain/twitch/repository"
+	twitchBreaker "github.com/rl404/shimakaze/internal/domain/twitch/repository/breaker"
 	twitchClient "github.com/rl404/shimakaze/internal/domain/twitch/repository/client"
 	vtuberRepository "github.com/rl404/shimakaze/internal/domain/vtuber/repository"
 	vtuberMongo "github.com/rl404/shimakaze/internal/domain/vtuber/repository/mongo"
+	vtuberChangeRepository "github.com/rl404/shimakaze/internal/domain/vtuber_change/repository"
+	vtuberChangeMongo "github.com/rl404/shimakaze/internal/domain/vtuber_change/repository/mongo"
 	wikiaRepository "github.com/rl404/shimakaze/internal/domain/wikia/repository"
+	wikiaBreaker "github.com/rl404/shimakaze/internal/domain/wikia/repository/breaker"
 	wikiaClient "github.com/rl404/shimakaze/internal/domain/wikia/repository/client"
 	youtubeRepository "github.com/rl404/shimakaze/internal/domain/youtube/repository"
+	youtubeBreaker "github.com/rl404/shimakaze/internal/domain/youtube/repository/breaker"
 	youtubeClient "github.com/rl404/shimakaze/internal/domain/youtube/repository/client"
 	"github.com/rl404/shimakaze/internal/service"
 	"github.com/rl404/shimakaze/internal/utils"
@@ -63,6 +75,15 @@ func consumer() error {
 		utils.Info("newrelic initialized")
 	}
 
+	// Init cache.
+	c, err := cache.New(cacheType[cfg.Cache.Dialect], cfg.Cache.Address, cfg.Cache.Password, cfg.Cache.Time)
+	if err != nil {
+		return err
+	}
+	c = nrCache.New(cfg.Cache.Dialect, cfg.Cache.Address, c)
+	utils.Info("cache initialized")
+	defer c.Close()
+
 	// Init in-memory.
 	im, err := cache.New(cache.InMemory, "", "", time.Hour)
 	if err != nil {
@@ -80,6 +101,12 @@ func consumer() error {
 	utils.Info("database initialized")
 	defer db.Client().Disconnect(context.Background())
 
+	// Init database indexes.
+	if err := createIndexes(db, cfg.Cron); err != nil {
+		return err
+	}
+	utils.Info("database index initialized")
+
 	// Init pubsub.
 	ps, err := pubsub.New(pubsubType[cfg.PubSub.Dialect], cfg.PubSub.Address, cfg.PubSub.Password)
 	if err != nil {
@@ -89,12 +116,19 @@ func consumer() error {
 	utils.Info("pubsub initialized")
 	defer ps.Close()
 
+	// Init circuit breaker.
+	breakers := newBreakers(c, cfg.Cache, cfg.Breaker)
+	utils.Info("circuit breaker initialized")
+	defer breakers.close()
+
 	// Init wikia.
-	var wikia wikiaRepository.Repository = wikiaClient.New()
+	var wikia wikiaRepository.Repository
+	wikia = wikiaClient.New()
+	wikia = wikiaBreaker.New(breakers.wikia, wikia)
 	utils.Info("repository wikia initialized")
 
 	// Init vtuber.
-	var vtuber vtuberRepository.Repository = vtuberMongo.New(db, cfg.Cron.ActiveAge, cfg.Cron.RetiredAge)
+	var vtuber vtuberRepository.Repository = vtuberMongo.New(db, cfg.Cron.ActiveAge, cfg.Cron.RetiredAge, cfg.Activity.HiatusAge, cfg.Activity.DormantAge)
 	utils.Info("repository vtuber initialized")
 
 	// Init non-vtuber.
@@ -114,27 +148,49 @@ func consumer() error {
 	utils.Info("repository channel-stats-history initialized")
 
 	// Init publisher.
-	var publisher publisherRepository.Repository = publisherPubsub.New(ps, pubsubTopic)
+	var publisher publisherRepository.Repository = publisherPubsub.New(ps, pubsubTopic, milestoneTopic)
 	utils.Info("repository publisher initialized")
 
 	// Init youtube.
-	var youtube youtubeRepository.Repository = youtubeClient.New(cfg.Youtube.Keys, cfg.Youtube.MaxAge)
+	var youtube youtubeRepository.Repository
+	youtube = youtubeClient.New(cfg.Youtube.Keys, cfg.Youtube.MaxAge, cfg.Youtube.RateLimit)
+	youtube = youtubeBreaker.New(breakers.youtube, youtube)
 	utils.Info("repository youtube initialized")
 
 	// Init twitch.
-	var twitch twitchRepository.Repository = twitchClient.New(im, cfg.Twitch.ClientID, cfg.Twitch.ClientSecret, cfg.Twitch.MaxAge)
+	var twitch twitchRepository.Repository
+	twitch = twitchClient.New(im, cfg.Twitch.ClientID, cfg.Twitch.ClientSecret, cfg.Twitch.MaxAge, cfg.Twitch.ScheduleAge, cfg.Twitch.RateLimit, cfg.Twitch.EventSubCallback, cfg.Twitch.EventSubSecret)
+	twitch = twitchBreaker.New(breakers.twitch, twitch)
 	utils.Info("repository twitch initialized")
 
 	// Init bilibili.
-	var bilibili bilibiliRepository.Repository = bilibiliClient.New(cfg.Bilibili.MaxAge)
+	var bilibili bilibiliRepository.Repository
+	bilibili = bilibiliClient.New(cfg.Bilibili.MaxAge, cfg.Bilibili.RateLimit)
+	bilibili = bilibiliBreaker.New(breakers.bilibili, bilibili)
 	utils.Info("repository bilibili initialized")
 
 	// Init niconico.
-	var niconico niconicoRepository.Repository = niconicoClient.New(cfg.Niconico.MaxAge)
+	var niconico niconicoRepository.Repository
+	niconico = niconicoClient.New(cfg.Niconico.MaxAge, cfg.Niconico.RateLimit)
+	niconico = niconicoBreaker.New(breakers.niconico, niconico)
 	utils.Info("repository niconico initialized")
 
+	// Init vtuber change.
+	var vtuberChange vtuberChangeRepository.Repository = vtuberChangeMongo.New(db)
+	utils.Info("repository vtuber-change initialized")
+
+	// Init milestone.
+	var milestone milestoneRepository.Repository = milestoneMongo.New(db, cfg.Milestone.Subscribers)
+	utils.Info("repository milestone initialized")
+
+	// Init search.
+	var search searchRepository.Repository
+	search = searchMemory.New()
+	search = searchCache.New(c, search)
+	utils.Info("repository search initialized")
+
 	// Init service.
-	service := service.New(wikia, vtuber, nonVtuber, agency, language, channelStatsHistory, publisher, youtube, twitch, bilibili, niconico, nil, nil, nil)
+	service := service.New(wikia, vtuber, nonVtuber, agency, language, channelStatsHistory, publisher, youtube, twitch, bilibili, niconico, nil, nil, nil, nil, nil, vtuberChange, milestone, search, nil)
 	utils.Info("service initialized")
 
 	// Init consumer.
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	_nr "github.com/rl404/fairy/log/newrelic"
+	nrCache "github.com/rl404/fairy/monitoring/newrelic/cache"
+	"github.com/rl404/shimakaze/internal/delivery/cron"
+	twitchRepository "github.com/rl404/shimakaze/internal/domain/twitch/repository"
+	twitchClient "github.com/rl404/shimakaze/internal/domain/twitch/repository/client"
+	vtuberRepository "github.com/rl404/shimakaze/internal/domain/vtuber/repository"
+	vtuberMongo "github.com/rl404/shimakaze/internal/domain/vtuber/repository/mongo"
+	"github.com/rl404/shimakaze/internal/service"
+	"github.com/rl404/shimakaze/internal/utils"
+	"github.com/rl404/shimakaze/pkg/cache"
+)
+
+func cronEventSub() error {
+	// Get config.
+	cfg, err := getConfig()
+	if err != nil {
+		return err
+	}
+	utils.Info("config initialized")
+
+	// Init newrelic.
+	nrApp, err := newrelic.NewApplication(
+		newrelic.ConfigAppName(cfg.Newrelic.Name),
+		newrelic.ConfigLicense(cfg.Newrelic.LicenseKey),
+		newrelic.ConfigDistributedTracerEnabled(true),
+		newrelic.ConfigAppLogForwardingEnabled(true),
+	)
+	if err != nil {
+		utils.Error(err.Error())
+	} else {
+		defer nrApp.Shutdown(10 * time.Second)
+		utils.AddLog(_nr.NewFromNewrelicApp(nrApp, _nr.LogLevel(cfg.Log.Level)))
+		utils.Info("newrelic initialized")
+	}
+
+	// Init in-memory.
+	im, err := cache.New(cache.InMemory, "", "", time.Hour)
+	if err != nil {
+		return err
+	}
+	im = nrCache.New("inmemory", "inmemory", im)
+	utils.Info("in-memory initialized")
+	defer im.Close()
+
+	// Init db.
+	db, err := newDB(cfg.DB)
+	if err != nil {
+		return err
+	}
+	utils.Info("database initialized")
+	defer db.Client().Disconnect(context.Background())
+
+	// Init vtuber.
+	var vtuber vtuberRepository.Repository = vtuberMongo.New(db, cfg.Cron.ActiveAge, cfg.Cron.RetiredAge, cfg.Activity.HiatusAge, cfg.Activity.DormantAge)
+	utils.Info("repository vtuber initialized")
+
+	// Init twitch.
+	var twitch twitchRepository.Repository = twitchClient.New(im, cfg.Twitch.ClientID, cfg.Twitch.ClientSecret, cfg.Twitch.MaxAge, cfg.Twitch.ScheduleAge, cfg.Twitch.RateLimit, cfg.Twitch.EventSubCallback, cfg.Twitch.EventSubSecret)
+	utils.Info("repository twitch initialized")
+
+	// Init service.
+	service := service.New(nil, vtuber, nil, nil, nil, nil, nil, nil, twitch, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	utils.Info("service initialized")
+
+	// Run cron.
+	utils.Info("subscribing eventsub...")
+	if err := cron.New(service, nrApp).EventSub(); err != nil {
+		return err
+	}
+
+	utils.Info("done")
+	return nil
+}
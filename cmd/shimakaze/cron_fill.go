@@ -72,7 +72,7 @@ func cronFill() error {
 	utils.Info("repository wikia initialized")
 
 	// Init vtuber.
-	var vtuber vtuberRepository.Repository = vtuberMongo.New(db, cfg.Cron.ActiveAge, cfg.Cron.RetiredAge)
+	var vtuber vtuberRepository.Repository = vtuberMongo.New(db, cfg.Cron.ActiveAge, cfg.Cron.RetiredAge, cfg.Activity.HiatusAge, cfg.Activity.DormantAge)
 	utils.Info("repository vtuber initialized")
 
 	// Init non-vtuber.
@@ -92,11 +92,11 @@ func cronFill() error {
 	utils.Info("repository channel-stats-history initialized")
 
 	// Init publisher.
-	var publisher publisherRepository.Repository = publisherPubsub.New(ps, pubsubTopic)
+	var publisher publisherRepository.Repository = publisherPubsub.New(ps, pubsubTopic, milestoneTopic)
 	utils.Info("repository publisher initialized")
 
 	// Init service.
-	service := service.New(wikia, vtuber, nonVtuber, agency, language, channelStatsHistory, publisher, nil, nil, nil, nil, nil, nil, nil)
+	service := service.New(wikia, vtuber, nonVtuber, agency, language, channelStatsHistory, publisher, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	utils.Info("service initialized")
 
 	// Run cron.
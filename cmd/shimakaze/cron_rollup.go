@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	_nr "github.com/rl404/fairy/log/newrelic"
+	"github.com/rl404/shimakaze/internal/delivery/cron"
+	channelStatsHistoryRepository "github.com/rl404/shimakaze/internal/domain/channel_stats_history/repository"
+	channelStatsHistoryMongo "github.com/rl404/shimakaze/internal/domain/channel_stats_history/repository/mongo"
+	"github.com/rl404/shimakaze/internal/service"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+func cronRollup() error {
+	// Get config.
+	cfg, err := getConfig()
+	if err != nil {
+		return err
+	}
+	utils.Info("config initialized")
+
+	// Init newrelic.
+	nrApp, err := newrelic.NewApplication(
+		newrelic.ConfigAppName(cfg.Newrelic.Name),
+		newrelic.ConfigLicense(cfg.Newrelic.LicenseKey),
+		newrelic.ConfigDistributedTracerEnabled(true),
+		newrelic.ConfigAppLogForwardingEnabled(true),
+	)
+	if err != nil {
+		utils.Error(err.Error())
+	} else {
+		defer nrApp.Shutdown(10 * time.Second)
+		utils.AddLog(_nr.NewFromNewrelicApp(nrApp, _nr.LogLevel(cfg.Log.Level)))
+		utils.Info("newrelic initialized")
+	}
+
+	// Init db.
+	db, err := newDB(cfg.DB)
+	if err != nil {
+		return err
+	}
+	utils.Info("database initialized")
+	defer db.Client().Disconnect(context.Background())
+
+	// Init channel stats history.
+	var channelStatsHistory channelStatsHistoryRepository.Repository = channelStatsHistoryMongo.New(db)
+	utils.Info("repository channel-stats-history initialized")
+
+	// Init service.
+	service := service.New(nil, nil, nil, nil, nil, channelStatsHistory, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	utils.Info("service initialized")
+
+	// Run cron.
+	utils.Info("rolling up channel stats history...")
+	if err := cron.New(service, nrApp).Rollup(cfg.Cron.StatsRawAge, cfg.Cron.StatsDailyAge); err != nil {
+		return err
+	}
+
+	utils.Info("done")
+	return nil
+}
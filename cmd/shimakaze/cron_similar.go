@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	_nr "github.com/rl404/fairy/log/newrelic"
+	"github.com/rl404/shimakaze/internal/delivery/cron"
+	similarRepository "github.com/rl404/shimakaze/internal/domain/similar/repository"
+	similarMongo "github.com/rl404/shimakaze/internal/domain/similar/repository/mongo"
+	vtuberRepository "github.com/rl404/shimakaze/internal/domain/vtuber/repository"
+	vtuberMongo "github.com/rl404/shimakaze/internal/domain/vtuber/repository/mongo"
+	"github.com/rl404/shimakaze/internal/service"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+func cronSimilar() error {
+	// Get config.
+	cfg, err := getConfig()
+	if err != nil {
+		return err
+	}
+	utils.Info("config initialized")
+
+	// Init newrelic.
+	nrApp, err := newrelic.NewApplication(
+		newrelic.ConfigAppName(cfg.Newrelic.Name),
+		newrelic.ConfigLicense(cfg.Newrelic.LicenseKey),
+		newrelic.ConfigDistributedTracerEnabled(true),
+		newrelic.ConfigAppLogForwardingEnabled(true),
+	)
+	if err != nil {
+		utils.Error(err.Error())
+	} else {
+		defer nrApp.Shutdown(10 * time.Second)
+		utils.AddLog(_nr.NewFromNewrelicApp(nrApp, _nr.LogLevel(cfg.Log.Level)))
+		utils.Info("newrelic initialized")
+	}
+
+	// Init db.
+	db, err := newDB(cfg.DB)
+	if err != nil {
+		return err
+	}
+	utils.Info("database initialized")
+	defer db.Client().Disconnect(context.Background())
+
+	// Init vtuber.
+	var vtuber vtuberRepository.Repository = vtuberMongo.New(db, cfg.Cron.ActiveAge, cfg.Cron.RetiredAge, cfg.Activity.HiatusAge, cfg.Activity.DormantAge)
+	utils.Info("repository vtuber initialized")
+
+	// Init similar vtuber.
+	var similar similarRepository.Repository = similarMongo.New(db)
+	utils.Info("repository similar initialized")
+
+	// Init similar vtuber weights.
+	request := service.UpdateSimilarVtubersRequest{
+		Limit:               cfg.Similar.Limit,
+		AgencyWeight:        cfg.Similar.AgencyWeight,
+		LanguageWeight:      cfg.Similar.LanguageWeight,
+		CreatorWeight:       cfg.Similar.CreatorWeight,
+		DebutWeight:         cfg.Similar.DebutWeight,
+		StreamingHourWeight: cfg.Similar.StreamingHourWeight,
+		SubscriberWeight:    cfg.Similar.SubscriberWeight,
+	}
+
+	// Init service.
+	service := service.New(nil, vtuber, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, similar)
+	utils.Info("service initialized")
+
+	// Run cron.
+	utils.Info("updating similar vtubers...")
+	if err := cron.New(service, nrApp).Similar(request); err != nil {
+		return err
+	}
+
+	utils.Info("done")
+	return nil
+}
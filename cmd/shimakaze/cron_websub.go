@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	_nr "github.com/rl404/fairy/log/newrelic"
+	"github.com/rl404/shimakaze/internal/delivery/cron"
+	vtuberRepository "github.com/rl404/shimakaze/internal/domain/vtuber/repository"
+	vtuberMongo "github.com/rl404/shimakaze/internal/domain/vtuber/repository/mongo"
+	websubRepository "github.com/rl404/shimakaze/internal/domain/websub/repository"
+	websubClient "github.com/rl404/shimakaze/internal/domain/websub/repository/client"
+	"github.com/rl404/shimakaze/internal/service"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+func cronWebSub() error {
+	// Get config.
+	cfg, err := getConfig()
+	if err != nil {
+		return err
+	}
+	utils.Info("config initialized")
+
+	// Init newrelic.
+	nrApp, err := newrelic.NewApplication(
+		newrelic.ConfigAppName(cfg.Newrelic.Name),
+		newrelic.ConfigLicense(cfg.Newrelic.LicenseKey),
+		newrelic.ConfigDistributedTracerEnabled(true),
+		newrelic.ConfigAppLogForwardingEnabled(true),
+	)
+	if err != nil {
+		utils.Error(err.Error())
+	} else {
+		defer nrApp.Shutdown(10 * time.Second)
+		utils.AddLog(_nr.NewFromNewrelicApp(nrApp, _nr.LogLevel(cfg.Log.Level)))
+		utils.Info("newrelic initialized")
+	}
+
+	// Init db.
+	db, err := newDB(cfg.DB)
+	if err != nil {
+		return err
+	}
+	utils.Info("database initialized")
+	defer db.Client().Disconnect(context.Background())
+
+	// Init vtuber.
+	var vtuber vtuberRepository.Repository = vtuberMongo.New(db, cfg.Cron.ActiveAge, cfg.Cron.RetiredAge, cfg.Activity.HiatusAge, cfg.Activity.DormantAge)
+	utils.Info("repository vtuber initialized")
+
+	// Init websub.
+	var websub websubRepository.Repository = websubClient.New(cfg.Youtube.WebSubHub, cfg.Youtube.WebSubCallback, cfg.Youtube.WebSubSecret, cfg.Youtube.WebSubLease)
+	utils.Info("repository websub initialized")
+
+	// Init service.
+	service := service.New(nil, vtuber, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, websub, nil, nil, nil, nil, nil)
+	utils.Info("service initialized")
+
+	// Run cron.
+	utils.Info("renewing websub subscription...")
+	if err := cron.New(service, nrApp).WebSub(); err != nil {
+		return err
+	}
+
+	utils.Info("done")
+	return nil
+}
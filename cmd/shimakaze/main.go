@@ -54,6 +54,38 @@ func main() {
 		},
 	})
 
+	cronCmd.AddCommand(&cobra.Command{
+		Use:   "websub",
+		Short: "Renew websub subscription",
+		RunE: func(*cobra.Command, []string) error {
+			return cronWebSub()
+		},
+	})
+
+	cronCmd.AddCommand(&cobra.Command{
+		Use:   "eventsub",
+		Short: "Subscribe eventsub",
+		RunE: func(*cobra.Command, []string) error {
+			return cronEventSub()
+		},
+	})
+
+	cronCmd.AddCommand(&cobra.Command{
+		Use:   "rollup",
+		Short: "Roll up old channel stats history",
+		RunE: func(*cobra.Command, []string) error {
+			return cronRollup()
+		},
+	})
+
+	cronCmd.AddCommand(&cobra.Command{
+		Use:   "similar",
+		Short: "Precompute similar vtubers",
+		RunE: func(*cobra.Command, []string) error {
+			return cronSimilar()
+		},
+	})
+
 	cmd.AddCommand(&cronCmd)
 
 	if err := cmd.Execute(); err != nil {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/redis/go-redis/v9"
 	_nr "github.com/rl404/fairy/log/newrelic"
 	nrCache "github.com/rl404/fairy/monitoring/newrelic/cache"
 	nrPS "github.com/rl404/fairy/monitoring/newrelic/pubsub"
@@ -24,22 +25,39 @@ import (
 	languageRepository "github.com/rl404/shimakaze/internal/domain/language/repository"
 	languageCache "github.com/rl404/shimakaze/internal/domain/language/repository/cache"
 	languageMongo "github.com/rl404/shimakaze/internal/domain/language/repository/mongo"
+	milestoneRepository "github.com/rl404/shimakaze/internal/domain/milestone/repository"
+	milestoneMongo "github.com/rl404/shimakaze/internal/domain/milestone/repository/mongo"
 	nonVtuberRepository "github.com/rl404/shimakaze/internal/domain/non_vtuber/repository"
 	nonVtuberCache "github.com/rl404/shimakaze/internal/domain/non_vtuber/repository/cache"
 	nonVtuberMongo "github.com/rl404/shimakaze/internal/domain/non_vtuber/repository/mongo"
 	publisherRepository "github.com/rl404/shimakaze/internal/domain/publisher/repository"
 	publisherPubsub "github.com/rl404/shimakaze/internal/domain/publisher/repository/pubsub"
+	searchRepository "github.com/rl404/shimakaze/internal/domain/search/repository"
+	searchCache "github.com/rl404/shimakaze/internal/domain/search/repository/cache"
+	searchMemory "github.com/rl404/shimakaze/internal/domain/search/repository/memory"
+	similarRepository "github.com/rl404/shimakaze/internal/domain/similar/repository"
+	similarMongo "github.com/rl404/shimakaze/internal/domain/similar/repository/mongo"
 	ssoRepository "github.com/rl404/shimakaze/internal/domain/sso/repository"
 	ssoClient "github.com/rl404/shimakaze/internal/domain/sso/repository/client"
+	streamSessionRepository "github.com/rl404/shimakaze/internal/domain/stream_session/repository"
+	streamSessionMongo "github.com/rl404/shimakaze/internal/domain/stream_session/repository/mongo"
 	tokenRepository "github.com/rl404/shimakaze/internal/domain/token/repository"
 	tokenToken "github.com/rl404/shimakaze/internal/domain/token/repository/cache"
+	twitchRepository "github.com/rl404/shimakaze/internal/domain/twitch/repository"
+	twitchBreaker "github.com/rl404/shimakaze/internal/domain/twitch/repository/breaker"
+	twitchClient "github.com/rl404/shimakaze/internal/domain/twitch/repository/client"
 	userRepository "github.com/rl404/shimakaze/internal/domain/user/repository"
 	userCache "github.com/rl404/shimakaze/internal/domain/user/repository/cache"
 	userMongo "github.com/rl404/shimakaze/internal/domain/user/repository/mongo"
 	vtuberRepository "github.com/rl404/shimakaze/internal/domain/vtuber/repository"
 	vtuberCache "github.com/rl404/shimakaze/internal/domain/vtuber/repository/cache"
 	vtuberMongo "github.com/rl404/shimakaze/internal/domain/vtuber/repository/mongo"
+	vtuberChangeRepository "github.com/rl404/shimakaze/internal/domain/vtuber_change/repository"
+	vtuberChangeMongo "github.com/rl404/shimakaze/internal/domain/vtuber_change/repository/mongo"
+	websubRepository "github.com/rl404/shimakaze/internal/domain/websub/repository"
+	websubClient "github.com/rl404/shimakaze/internal/domain/websub/repository/client"
 	wikiaRepository "github.com/rl404/shimakaze/internal/domain/wikia/repository"
+	wikiaBreaker "github.com/rl404/shimakaze/internal/domain/wikia/repository/breaker"
 	wikiaClient "github.com/rl404/shimakaze/internal/domain/wikia/repository/client"
 	"github.com/rl404/shimakaze/internal/service"
 	"github.com/rl404/shimakaze/internal/utils"
@@ -77,6 +95,14 @@ func server() error {
 		return err
 	}
 	c = nrCache.New(cfg.Cache.Dialect, cfg.Cache.Address, c)
+	if cfg.Cache.Dialect == "redis" {
+		rc := redis.NewClient(&redis.Options{
+			Addr:     cfg.Cache.Address,
+			Password: cfg.Cache.Password,
+		})
+		defer rc.Close()
+		c = cache.WithRedisMultiGet(c, rc)
+	}
 	utils.Info("cache initialized")
 	defer c.Close()
 
@@ -97,6 +123,12 @@ func server() error {
 	utils.Info("database initialized")
 	defer db.Client().Disconnect(context.Background())
 
+	// Init database indexes.
+	if err := createIndexes(db, cfg.Cron); err != nil {
+		return err
+	}
+	utils.Info("database index initialized")
+
 	// Init pubsub.
 	ps, err := pubsub.New(pubsubType[cfg.PubSub.Dialect], cfg.PubSub.Address, cfg.PubSub.Password)
 	if err != nil {
@@ -106,13 +138,20 @@ func server() error {
 	utils.Info("pubsub initialized")
 	defer ps.Close()
 
+	// Init circuit breaker.
+	breakers := newBreakers(c, cfg.Cache, cfg.Breaker)
+	utils.Info("circuit breaker initialized")
+	defer breakers.close()
+
 	// Init wikia.
-	var wikia wikiaRepository.Repository = wikiaClient.New()
+	var wikia wikiaRepository.Repository
+	wikia = wikiaClient.New()
+	wikia = wikiaBreaker.New(breakers.wikia, wikia)
 	utils.Info("repository wikia initialized")
 
 	// Init vtuber.
 	var vtuber vtuberRepository.Repository
-	vtuber = vtuberMongo.New(db, cfg.Cron.ActiveAge, cfg.Cron.RetiredAge)
+	vtuber = vtuberMongo.New(db, cfg.Cron.ActiveAge, cfg.Cron.RetiredAge, cfg.Activity.HiatusAge, cfg.Activity.DormantAge)
 	vtuber = vtuberCache.New(c, vtuber)
 	vtuber = vtuberCache.New(im, vtuber)
 	utils.Info("repository vtuber initialized")
@@ -146,7 +185,7 @@ func server() error {
 	utils.Info("repository channel-stats-history initialized")
 
 	// Init publisher.
-	var publisher publisherRepository.Repository = publisherPubsub.New(ps, pubsubTopic)
+	var publisher publisherRepository.Repository = publisherPubsub.New(ps, pubsubTopic, milestoneTopic)
 	utils.Info("repository publisher initialized")
 
 	// Init sso.
@@ -164,8 +203,40 @@ func server() error {
 	var token tokenRepository.Repository = tokenToken.New(c, cfg.JWT.AccessSecret, cfg.JWT.AccessExpired, cfg.JWT.RefreshSecret, cfg.JWT.RefreshExpired)
 	utils.Info("repository token initialized")
 
+	// Init websub.
+	var websub websubRepository.Repository = websubClient.New(cfg.Youtube.WebSubHub, cfg.Youtube.WebSubCallback, cfg.Youtube.WebSubSecret, cfg.Youtube.WebSubLease)
+	utils.Info("repository websub initialized")
+
+	// Init twitch.
+	var twitch twitchRepository.Repository
+	twitch = twitchClient.New(c, cfg.Twitch.ClientID, cfg.Twitch.ClientSecret, cfg.Twitch.MaxAge, cfg.Twitch.ScheduleAge, cfg.Twitch.RateLimit, cfg.Twitch.EventSubCallback, cfg.Twitch.EventSubSecret)
+	twitch = twitchBreaker.New(breakers.twitch, twitch)
+	utils.Info("repository twitch initialized")
+
+	// Init stream session.
+	var streamSession streamSessionRepository.Repository = streamSessionMongo.New(db)
+	utils.Info("repository stream-session initialized")
+
+	// Init vtuber change.
+	var vtuberChange vtuberChangeRepository.Repository = vtuberChangeMongo.New(db)
+	utils.Info("repository vtuber-change initialized")
+
+	// Init milestone.
+	var milestone milestoneRepository.Repository = milestoneMongo.New(db, cfg.Milestone.Subscribers)
+	utils.Info("repository milestone initialized")
+
+	// Init search.
+	var search searchRepository.Repository
+	search = searchMemory.New()
+	search = searchCache.New(c, search)
+	utils.Info("repository search initialized")
+
+	// Init similar vtuber.
+	var similar similarRepository.Repository = similarMongo.New(db)
+	utils.Info("repository similar initialized")
+
 	// Init service.
-	service := service.New(wikia, vtuber, nonVtuber, agency, language, channelStatsHistory, publisher, nil, nil, nil, nil, sso, user, token)
+	service := service.New(wikia, vtuber, nonVtuber, agency, language, channelStatsHistory, publisher, nil, twitch, nil, nil, sso, user, token, websub, streamSession, vtuberChange, milestone, search, similar)
 	utils.Info("service initialized")
 
 	// Init web server.
@@ -183,7 +254,7 @@ func server() error {
 	utils.Info("http server middleware initialized")
 
 	// Register ping route.
-	ping.New().Register(r)
+	ping.New(breakers.list()...).Register(r)
 	utils.Info("http route ping initialized")
 
 	// Register swagger route.
@@ -194,6 +265,12 @@ func server() error {
 	api.New(service, cfg.JWT.AccessSecret, cfg.JWT.RefreshSecret).Register(r, nrApp)
 	utils.Info("http route api initialized")
 
+	// Refresh search index periodically.
+	searchCtx, searchCancel := context.WithCancel(context.Background())
+	defer searchCancel()
+	go refreshSearchIndex(searchCtx, service, cfg.Search.RefreshInterval, cfg.Search.CheckInterval)
+	utils.Info("search index refresher started")
+
 	// Run web server.
 	httpServerChan := httpServer.Run()
 	utils.Info("http server listening at :%s", cfg.HTTP.Port)
@@ -210,3 +287,32 @@ func server() error {
 
 	return nil
 }
+
+// refreshSearchIndex to rebuild search index periodically,
+// and sooner if the index is marked as stale on data update.
+func refreshSearchIndex(ctx context.Context, service service.Service, refreshInterval, checkInterval time.Duration) {
+	refreshTicker := time.NewTicker(refreshInterval)
+	defer refreshTicker.Stop()
+
+	checkTicker := time.NewTicker(checkInterval)
+	defer checkTicker.Stop()
+
+	if _, err := service.RefreshSearchIndex(ctx); err != nil {
+		utils.Error(err.Error())
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-refreshTicker.C:
+			if _, err := service.RefreshSearchIndex(ctx); err != nil {
+				utils.Error(err.Error())
+			}
+		case <-checkTicker.C:
+			if _, err := service.RefreshStaleSearchIndex(ctx); err != nil {
+				utils.Error(err.Error())
+			}
+		}
+	}
+}
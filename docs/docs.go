@@ -15,6 +15,205 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/admin/channels/anomalies": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Get channel stats flagged as subscriber anomaly.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer jwt.admin_access.token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "vtuber id",
+                        "name": "vtuber_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "YOUTUBE",
+                            "TWITCH",
+                            "BILIBILI",
+                            "NICONICO"
+                        ],
+                        "type": "string",
+                        "description": "channel type",
+                        "name": "channel_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.channelStatsAnomaly"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/channels/failing": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Get channels failing to be fetched repeatedly.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer jwt.admin_access.token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "YOUTUBE",
+                            "TWITCH",
+                            "BILIBILI",
+                            "NICONICO"
+                        ],
+                        "type": "string",
+                        "description": "channel type",
+                        "name": "channel_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 3,
+                        "description": "min consecutive failure count",
+                        "name": "min_fail_count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.failingChannel"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/admin/non-vtubers": {
             "get": {
                 "produces": [
@@ -51,6 +250,12 @@ const docTemplate = `{
                         "description": "limit",
                         "name": "limit",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cursor of next page, page is ignored and total is not counted if set",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -454,7 +659,17 @@ const docTemplate = `{
                             "member",
                             "-member",
                             "subscriber",
-                            "-subscriber"
+                            "-subscriber",
+                            "youtube_subscriber",
+                            "-youtube_subscriber",
+                            "twitch_subscriber",
+                            "-twitch_subscriber",
+                            "bilibili_subscriber",
+                            "-bilibili_subscriber",
+                            "niconico_subscriber",
+                            "-niconico_subscriber",
+                            "total_subscriber",
+                            "-total_subscriber"
                         ],
                         "type": "string",
                         "default": "name",
@@ -514,7 +729,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/agencies/{id}": {
+        "/agencies/batch": {
             "get": {
                 "produces": [
                     "application/json"
@@ -522,13 +737,13 @@ const docTemplate = `{
                 "tags": [
                     "Agency"
                 ],
-                "summary": "Get agency data.",
+                "summary": "Get agency data by ids.",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "wikia id",
-                        "name": "id",
-                        "in": "path",
+                        "type": "string",
+                        "description": "comma separated wikia ids (max 100)",
+                        "name": "ids",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -544,7 +759,13 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/service.agency"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.agency"
+                                            }
+                                        },
+                                        "meta": {
+                                            "$ref": "#/definitions/service.batch"
                                         }
                                     }
                                 }
@@ -557,12 +778,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Response"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.Response"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -572,24 +787,28 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/callback": {
-            "post": {
+        "/agencies/{id}": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Agency"
                 ],
-                "summary": "Handle OAuth2 callback.",
+                "summary": "Get agency data.",
                 "parameters": [
                     {
-                        "description": "request body",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/service.AuthCallback"
-                        }
+                        "type": "integer",
+                        "description": "wikia id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "as of date (yyyy-mm-dd)",
+                        "name": "as_of",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -604,7 +823,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/service.Token"
+                                            "$ref": "#/definitions/service.agency"
                                         }
                                     }
                                 }
@@ -617,8 +836,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Response"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.Response"
                         }
@@ -632,39 +851,60 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/logout": {
-            "post": {
+        "/agencies/{id}/vtubers": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Agency"
                 ],
-                "summary": "Logout.",
+                "summary": "Get agency member list.",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Bearer jwt.access.token",
-                        "name": "Authorization",
-                        "in": "header",
+                        "type": "integer",
+                        "description": "wikia id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "as of date (yyyy-mm-dd)",
+                        "name": "as_of",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.Response"
-                        }
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.vtuber"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.Response"
                         }
@@ -678,7 +918,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/token/refresh": {
+        "/auth/callback": {
             "post": {
                 "produces": [
                     "application/json"
@@ -686,14 +926,16 @@ const docTemplate = `{
                 "tags": [
                     "Auth"
                 ],
-                "summary": "Refresh Token.",
+                "summary": "Handle OAuth2 callback.",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Bearer jwt.refresh.token",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
+                        "description": "request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/service.AuthCallback"
+                        }
                     }
                 ],
                 "responses": {
@@ -736,35 +978,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/languages": {
-            "get": {
+        "/auth/logout": {
+            "post": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Language"
+                    "Auth"
+                ],
+                "summary": "Logout.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer jwt.access.token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
                 ],
-                "summary": "Get language data.",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/utils.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/service.language"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/utils.Response"
                         }
                     },
                     "400": {
@@ -773,6 +1009,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Response"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -782,19 +1024,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/profile": {
-            "get": {
+        "/auth/token/refresh": {
+            "post": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Auth"
                 ],
-                "summary": "Get user profile.",
+                "summary": "Refresh Token.",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Bearer jwt.access.token",
+                        "description": "Bearer jwt.refresh.token",
                         "name": "Authorization",
                         "in": "header",
                         "required": true
@@ -812,7 +1054,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/service.User"
+                                            "$ref": "#/definitions/service.Token"
                                         }
                                     }
                                 }
@@ -840,15 +1082,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/agencies/count": {
+        "/changes": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Statistic"
+                    "Change"
+                ],
+                "summary": "Get all vtuber field changes.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "field",
+                        "name": "field",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "WIKI",
+                            "OVERRIDE",
+                            "ADMIN"
+                        ],
+                        "type": "string",
+                        "description": "source",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get agency count.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -861,13 +1136,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "integer"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.vtuberChange"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -877,24 +1161,87 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/2d-modeler-count": {
-            "get": {
-                "produces": [
+        "/eventsub/twitch": {
+            "post": {
+                "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "text/plain"
+                ],
                 "tags": [
-                    "Statistic"
+                    "EventSub"
                 ],
-                "summary": "Get vtuber character 2d modeler count.",
+                "summary": "Receive twitch eventsub webhook.",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "top count",
-                        "name": "top",
-                        "in": "query"
+                        "type": "string",
+                        "description": "message id",
+                        "name": "Twitch-Eventsub-Message-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "webhook_callback_verification",
+                            "notification",
+                            "revocation"
+                        ],
+                        "type": "string",
+                        "description": "message type",
+                        "name": "Twitch-Eventsub-Message-Type",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "message timestamp",
+                        "name": "Twitch-Eventsub-Message-Timestamp",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "hmac signature",
+                        "name": "Twitch-Eventsub-Message-Signature",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "challenge"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
                     }
+                }
+            }
+        },
+        "/languages": {
+            "get": {
+                "produces": [
+                    "application/json"
                 ],
+                "tags": [
+                    "Language"
+                ],
+                "summary": "Get language data.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -909,7 +1256,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/service.vtuberDesignerCount"
+                                                "$ref": "#/definitions/service.language"
                                             }
                                         }
                                     }
@@ -932,21 +1279,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/3d-modeler-count": {
+        "/milestones/recent": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Statistic"
+                    "Milestone"
                 ],
-                "summary": "Get vtuber character 3d modeler count.",
+                "summary": "Get recent channel subscriber milestones.",
                 "parameters": [
+                    {
+                        "enum": [
+                            "YOUTUBE",
+                            "TWITCH",
+                            "BILIBILI",
+                            "NICONICO"
+                        ],
+                        "type": "string",
+                        "description": "channel type",
+                        "name": "channel_type",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "default": 10,
-                        "description": "top count",
-                        "name": "top",
+                        "description": "min milestone",
+                        "name": "min_milestone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "days",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "limit",
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
@@ -964,7 +1343,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/service.vtuberDesignerCount"
+                                                "$ref": "#/definitions/service.milestone"
                                             }
                                         }
                                     }
@@ -987,15 +1366,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/average-active-time": {
+        "/profile": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Statistic"
+                    "User"
+                ],
+                "summary": "Get user profile.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer jwt.access.token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
                 ],
-                "summary": "Get vtuber average active time.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1008,50 +1396,23 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "number",
-                                            "format": "float64"
+                                            "$ref": "#/definitions/service.User"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.Response"
                         }
-                    }
-                }
-            }
-        },
-        "/statistics/vtubers/average-height": {
-            "get": {
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Statistic"
-                ],
-                "summary": "Get vtuber average height.",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/utils.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "number",
-                                            "format": "float64"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/utils.Response"
                         }
                     },
                     "500": {
@@ -1063,7 +1424,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/average-video-count": {
+        "/statistics/agencies/count": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1071,7 +1432,7 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber average video count.",
+                "summary": "Get agency count.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1084,8 +1445,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "number",
-                                            "format": "float64"
+                                            "type": "integer"
                                         }
                                     }
                                 }
@@ -1101,7 +1461,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/average-video-duration": {
+        "/statistics/vtubers/2d-modeler-count": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1109,7 +1469,16 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber average video duration.",
+                "summary": "Get vtuber character 2d modeler count.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "top count",
+                        "name": "top",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1122,51 +1491,21 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "number",
-                                            "format": "float64"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.vtuberDesignerCount"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.Response"
                         }
-                    }
-                }
-            }
-        },
-        "/statistics/vtubers/average-weight": {
-            "get": {
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Statistic"
-                ],
-                "summary": "Get vtuber average weight.",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/utils.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "number",
-                                            "format": "float64"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -1177,7 +1516,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/birthday-count": {
+        "/statistics/vtubers/3d-modeler-count": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1185,7 +1524,16 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber birthday count.",
+                "summary": "Get vtuber character 3d modeler count.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "top count",
+                        "name": "top",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1200,7 +1548,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/service.vtuberBirthdayCount"
+                                                "$ref": "#/definitions/service.vtuberDesignerCount"
                                             }
                                         }
                                     }
@@ -1208,6 +1556,12 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1217,7 +1571,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/blood-type-count": {
+        "/statistics/vtubers/average-active-time": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1225,16 +1579,7 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber blood type count.",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 5,
-                        "description": "top count",
-                        "name": "top",
-                        "in": "query"
-                    }
-                ],
+                "summary": "Get vtuber average active time.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1247,22 +1592,14 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/service.vtuberBloodTypeCount"
-                                            }
+                                            "type": "number",
+                                            "format": "float64"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.Response"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1272,7 +1609,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/channel-type-count": {
+        "/statistics/vtubers/average-height": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1280,7 +1617,7 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber channel type count.",
+                "summary": "Get vtuber average height.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1293,10 +1630,8 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/service.vtuberChannelTypeCount"
-                                            }
+                                            "type": "number",
+                                            "format": "float64"
                                         }
                                     }
                                 }
@@ -1312,7 +1647,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/count": {
+        "/statistics/vtubers/average-video-count": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1320,7 +1655,7 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber count.",
+                "summary": "Get vtuber average video count.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1333,7 +1668,8 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "integer"
+                                            "type": "number",
+                                            "format": "float64"
                                         }
                                     }
                                 }
@@ -1349,7 +1685,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/count-by-status": {
+        "/statistics/vtubers/average-video-duration": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1357,7 +1693,7 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber status count.",
+                "summary": "Get vtuber average video duration.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1370,7 +1706,8 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/service.vtuberStatusCount"
+                                            "type": "number",
+                                            "format": "float64"
                                         }
                                     }
                                 }
@@ -1386,7 +1723,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/debut-retire-count-monthly": {
+        "/statistics/vtubers/average-weight": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1394,7 +1731,7 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber debut \u0026 retire count monthly.",
+                "summary": "Get vtuber average weight.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1407,10 +1744,8 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/service.vtuberDebutRetireCount"
-                                            }
+                                            "type": "number",
+                                            "format": "float64"
                                         }
                                     }
                                 }
@@ -1426,7 +1761,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/debut-retire-count-yearly": {
+        "/statistics/vtubers/birthday-count": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1434,7 +1769,7 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber debut \u0026 retire count yearly.",
+                "summary": "Get vtuber birthday count.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1449,7 +1784,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/service.vtuberDebutRetireCount"
+                                                "$ref": "#/definitions/service.vtuberBirthdayCount"
                                             }
                                         }
                                     }
@@ -1466,7 +1801,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/designer-count": {
+        "/statistics/vtubers/blood-type-count": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1474,11 +1809,11 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber character designer count.",
+                "summary": "Get vtuber blood type count.",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 10,
+                        "default": 5,
                         "description": "top count",
                         "name": "top",
                         "in": "query"
@@ -1498,7 +1833,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/service.vtuberDesignerCount"
+                                                "$ref": "#/definitions/service.vtuberBloodTypeCount"
                                             }
                                         }
                                     }
@@ -1521,7 +1856,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/gender-count": {
+        "/statistics/vtubers/channel-type-count": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1529,7 +1864,7 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber gender count.",
+                "summary": "Get vtuber channel type count.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1544,7 +1879,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/service.vtuberGenderCount"
+                                                "$ref": "#/definitions/service.vtuberChannelTypeCount"
                                             }
                                         }
                                     }
@@ -1561,7 +1896,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/in-agency-count": {
+        "/statistics/vtubers/count": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1569,7 +1904,7 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber in agency count.",
+                "summary": "Get vtuber count.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1582,7 +1917,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/service.vtuberInAgencyCount"
+                                            "type": "integer"
                                         }
                                     }
                                 }
@@ -1598,7 +1933,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/language-count": {
+        "/statistics/vtubers/count-by-status": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1606,7 +1941,7 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber language count.",
+                "summary": "Get vtuber status count.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1619,10 +1954,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/service.vtuberLanguageCount"
-                                            }
+                                            "$ref": "#/definitions/service.vtuberStatusCount"
                                         }
                                     }
                                 }
@@ -1638,7 +1970,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/model-count": {
+        "/statistics/vtubers/debut-retire-count-monthly": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1646,7 +1978,7 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber 2d \u0026 3d model count.",
+                "summary": "Get vtuber debut \u0026 retire count monthly.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1661,7 +1993,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/service.vtuberModelCount"
+                                                "$ref": "#/definitions/service.vtuberDebutRetireCount"
                                             }
                                         }
                                     }
@@ -1678,7 +2010,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/subscriber-count": {
+        "/statistics/vtubers/debut-retire-count-yearly": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1686,23 +2018,7 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber subscriber count.",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 100000,
-                        "description": "interval",
-                        "name": "interval",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 5000000,
-                        "description": "max",
-                        "name": "max",
-                        "in": "query"
-                    }
-                ],
+                "summary": "Get vtuber debut \u0026 retire count yearly.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1717,7 +2033,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/service.vtuberSubscriberCount"
+                                                "$ref": "#/definitions/service.vtuberDebutRetireCount"
                                             }
                                         }
                                     }
@@ -1725,12 +2041,6 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.Response"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1740,7 +2050,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/video-count": {
+        "/statistics/vtubers/designer-count": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1748,7 +2058,7 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber video count.",
+                "summary": "Get vtuber character designer count.",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1772,7 +2082,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/service.vtuberVideoCount"
+                                                "$ref": "#/definitions/service.vtuberDesignerCount"
                                             }
                                         }
                                     }
@@ -1795,7 +2105,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/video-count-by-date": {
+        "/statistics/vtubers/gender-count": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1803,18 +2113,119 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber video count by date.",
-                "parameters": [
-                    {
-                        "type": "boolean",
-                        "description": "hourly",
-                        "name": "hourly",
+                "summary": "Get vtuber gender count.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.vtuberGenderCount"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/statistics/vtubers/growth": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Statistic"
+                ],
+                "summary": "Get vtuber channel subscriber growth.",
+                "parameters": [
+                    {
+                        "enum": [
+                            "YOUTUBE",
+                            "TWITCH",
+                            "BILIBILI",
+                            "NICONICO"
+                        ],
+                        "type": "string",
+                        "description": "channel type",
+                        "name": "channel_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "agency id",
+                        "name": "agency_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "language id",
+                        "name": "language_id",
                         "in": "query"
                     },
                     {
                         "type": "boolean",
-                        "description": "daily",
-                        "name": "daily",
+                        "description": "exclude active",
+                        "name": "exclude_active",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "exclude retired",
+                        "name": "exclude_retired",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "subscriber",
+                            "-subscriber",
+                            "growth_7d",
+                            "-growth_7d",
+                            "growth_30d",
+                            "-growth_30d",
+                            "growth_365d",
+                            "-growth_365d",
+                            "percent_7d",
+                            "-percent_7d",
+                            "percent_30d",
+                            "-percent_30d",
+                            "percent_365d",
+                            "-percent_365d"
+                        ],
+                        "type": "string",
+                        "default": "-growth_30d",
+                        "description": "sort",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "limit",
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
@@ -1832,7 +2243,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/service.vtuberVideoCountByDate"
+                                                "$ref": "#/definitions/service.vtuberSubscriberGrowth"
                                             }
                                         }
                                     }
@@ -1855,7 +2266,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/video-duration": {
+        "/statistics/vtubers/in-agency-count": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1863,16 +2274,44 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber video duration.",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "top count",
-                        "name": "top",
-                        "in": "query"
+                "summary": "Get vtuber in agency count.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/service.vtuberInAgencyCount"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
                     }
+                }
+            }
+        },
+        "/statistics/vtubers/language-count": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Statistic"
                 ],
+                "summary": "Get vtuber language count.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1887,7 +2326,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/service.vtuberVideoDuration"
+                                                "$ref": "#/definitions/service.vtuberLanguageCount"
                                             }
                                         }
                                     }
@@ -1895,12 +2334,6 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.Response"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1910,7 +2343,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/statistics/vtubers/zodiac-count": {
+        "/statistics/vtubers/model-count": {
             "get": {
                 "produces": [
                     "application/json"
@@ -1918,7 +2351,7 @@ const docTemplate = `{
                 "tags": [
                     "Statistic"
                 ],
-                "summary": "Get vtuber zodiac count.",
+                "summary": "Get vtuber 2d \u0026 3d model count.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1933,7 +2366,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/service.vtuberZodiacCount"
+                                                "$ref": "#/definitions/service.vtuberModelCount"
                                             }
                                         }
                                     }
@@ -1950,57 +2383,96 @@ const docTemplate = `{
                 }
             }
         },
-        "/videos": {
+        "/statistics/vtubers/subscriber-count": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Video"
+                    "Statistic"
                 ],
-                "summary": "Get videos.",
+                "summary": "Get vtuber subscriber count.",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "start date",
-                        "name": "start_date",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "end date",
-                        "name": "end_date",
+                        "type": "integer",
+                        "default": 100000,
+                        "description": "interval",
+                        "name": "interval",
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "is finished",
-                        "name": "is_finished",
+                        "type": "integer",
+                        "default": 5000000,
+                        "description": "max",
+                        "name": "max",
                         "in": "query"
                     },
                     {
                         "enum": [
-                            "video_start_date",
-                            "-video_start_date"
+                            "YOUTUBE",
+                            "TWITCH",
+                            "BILIBILI",
+                            "NICONICO",
+                            "TOTAL"
                         ],
                         "type": "string",
-                        "default": "-video_start_date",
-                        "description": "sort",
-                        "name": "sort",
+                        "description": "subscriber platform",
+                        "name": "platform",
                         "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.vtuberSubscriberCount"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "page",
-                        "name": "page",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/statistics/vtubers/video-count": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Statistic"
+                ],
+                "summary": "Get vtuber video count.",
+                "parameters": [
                     {
                         "type": "integer",
-                        "default": 20,
-                        "description": "limit",
-                        "name": "limit",
+                        "default": 10,
+                        "description": "top count",
+                        "name": "top",
                         "in": "query"
                     }
                 ],
@@ -2018,7 +2490,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/service.video"
+                                                "$ref": "#/definitions/service.vtuberVideoCount"
                                             }
                                         }
                                     }
@@ -2041,22 +2513,336 @@ const docTemplate = `{
                 }
             }
         },
-        "/vtubers": {
+        "/statistics/vtubers/video-count-by-date": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Vtuber"
+                    "Statistic"
                 ],
-                "summary": "Get vtuber data.",
+                "summary": "Get vtuber video count by date.",
                 "parameters": [
                     {
-                        "enum": [
-                            "all",
-                            "simple"
-                        ],
-                        "type": "string",
+                        "type": "boolean",
+                        "description": "hourly",
+                        "name": "hourly",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "daily",
+                        "name": "daily",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.vtuberVideoCountByDate"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/statistics/vtubers/video-duration": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Statistic"
+                ],
+                "summary": "Get vtuber video duration.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "top count",
+                        "name": "top",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.vtuberVideoDuration"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/statistics/vtubers/zodiac-count": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Statistic"
+                ],
+                "summary": "Get vtuber zodiac count.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.vtuberZodiacCount"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/suggest": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Suggest"
+                ],
+                "summary": "Get vtuber, agency, and creator suggestions.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.suggestion"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/videos": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Video"
+                ],
+                "summary": "Get videos.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "start date",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "end date",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "is finished",
+                        "name": "is_finished",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "video_start_date",
+                            "-video_start_date"
+                        ],
+                        "type": "string",
+                        "default": "-video_start_date",
+                        "description": "sort",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cursor of next page, page is ignored and total is not counted if set",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.video"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/vtubers": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Vtuber"
+                ],
+                "summary": "Get vtuber data.",
+                "parameters": [
+                    {
+                        "enum": [
+                            "all",
+                            "simple"
+                        ],
+                        "type": "string",
                         "default": "all",
                         "description": "mode",
                         "name": "mode",
@@ -2064,7 +2850,13 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "names",
+                        "description": "full-text search query",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "names (kana/romaji \u0026 width insensitive)",
                         "name": "names",
                         "in": "query"
                     },
@@ -2166,50 +2958,188 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "character designer",
-                        "name": "character_designer",
+                        "description": "character designer",
+                        "name": "character_designer",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated character designers",
+                        "name": "character_designers",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated excluded character designers",
+                        "name": "exclude_character_designers",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "any",
+                        "description": "character designers match mode",
+                        "name": "character_designer_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "character 2d modeler",
+                        "name": "character_2d_modeler",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated character 2d modelers",
+                        "name": "character_2d_modelers",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated excluded character 2d modelers",
+                        "name": "exclude_character_2d_modelers",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "any",
+                        "description": "character 2d modelers match mode",
+                        "name": "character_2d_modeler_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "character 3d modeler",
+                        "name": "character_3d_modeler",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated character 3d modelers",
+                        "name": "character_3d_modelers",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated excluded character 3d modelers",
+                        "name": "exclude_character_3d_modelers",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "any",
+                        "description": "character 3d modelers match mode",
+                        "name": "character_3d_modeler_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "in agency",
+                        "name": "in_agency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "agency",
+                        "name": "agency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "agency id",
+                        "name": "agency_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated agency ids",
+                        "name": "agency_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated excluded agency ids",
+                        "name": "exclude_agency_ids",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "any",
+                        "description": "agency ids match mode",
+                        "name": "agency_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "language id",
+                        "name": "language_id",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "character 2d modeler",
-                        "name": "character_2d_modeler",
+                        "description": "comma separated language ids",
+                        "name": "language_ids",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "character 3d modeler",
-                        "name": "character_3d_modeler",
+                        "description": "comma separated excluded language ids",
+                        "name": "exclude_language_ids",
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "in agency",
-                        "name": "in_agency",
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "any",
+                        "description": "language ids match mode",
+                        "name": "language_match",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "agency",
-                        "name": "agency",
+                        "description": "channel types",
+                        "name": "channel_types",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "agency id",
-                        "name": "agency_id",
+                        "type": "string",
+                        "description": "comma separated excluded channel types",
+                        "name": "exclude_channel_types",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "language id",
-                        "name": "language_id",
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "all",
+                        "description": "channel types match mode",
+                        "name": "channel_type_match",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "channel types",
-                        "name": "channel_types",
+                        "description": "comma separated activity statuses (ACTIVE,HIATUS,DORMANT,RETIRED), prefix with - to exclude",
+                        "name": "activity_statuses",
                         "in": "query"
                     },
                     {
@@ -2260,6 +3190,19 @@ const docTemplate = `{
                         "name": "end_subscriber",
                         "in": "query"
                     },
+                    {
+                        "enum": [
+                            "YOUTUBE",
+                            "TWITCH",
+                            "BILIBILI",
+                            "NICONICO",
+                            "TOTAL"
+                        ],
+                        "type": "string",
+                        "description": "subscriber platform for start/end subscriber filter",
+                        "name": "subscriber_platform",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "start video count",
@@ -2273,42 +3216,267 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "enum": [
-                            "name",
-                            "-name",
-                            "debut_date",
-                            "-debut_date",
-                            "retirement_date",
-                            "-retirement_date",
-                            "subscriber",
-                            "-subscriber",
-                            "monthly_subscriber",
-                            "-monthly_subscriber",
-                            "video_count",
-                            "-video_count",
-                            "average_video_length",
-                            "-average_video_length",
-                            "total_video_length",
-                            "-total_video_length"
-                        ],
                         "type": "string",
-                        "default": "name",
-                        "description": "sort",
-                        "name": "sort",
-                        "in": "query"
+                        "description": "boolean filter expression, e.g. (agency_id:1 OR agency_id:2) AND NOT has_3d:true AND language_id IN (1,2)",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "relevance",
+                            "name",
+                            "-name",
+                            "debut_date",
+                            "-debut_date",
+                            "retirement_date",
+                            "-retirement_date",
+                            "subscriber",
+                            "-subscriber",
+                            "youtube_subscriber",
+                            "-youtube_subscriber",
+                            "twitch_subscriber",
+                            "-twitch_subscriber",
+                            "bilibili_subscriber",
+                            "-bilibili_subscriber",
+                            "niconico_subscriber",
+                            "-niconico_subscriber",
+                            "total_subscriber",
+                            "-total_subscriber",
+                            "monthly_subscriber",
+                            "-monthly_subscriber",
+                            "video_count",
+                            "-video_count",
+                            "average_video_length",
+                            "-average_video_length",
+                            "total_video_length",
+                            "-total_video_length",
+                            "activity_status",
+                            "-activity_status",
+                            "last_activity_date",
+                            "-last_activity_date"
+                        ],
+                        "type": "string",
+                        "default": "name",
+                        "description": "sort",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cursor of next page, page is ignored and total is not counted if set",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated response fields",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "all",
+                        "description": "channel videos (none, latest:N, all)",
+                        "name": "videos",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.vtuber"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/vtubers/2d-modelers": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Vtuber"
+                ],
+                "summary": "Get vtuber character 2D modelers.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "type": "string"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/vtubers/3d-modelers": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Vtuber"
+                ],
+                "summary": "Get vtuber character 3D modelers.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "type": "string"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/vtubers/agency-trees": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Vtuber"
+                ],
+                "summary": "Get vtuber agency trees.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/service.vtuberAgencyTree"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/vtubers/batch": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Vtuber"
+                ],
+                "summary": "Get vtuber data by ids.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "comma separated wikia ids (max 100)",
+                        "name": "ids",
+                        "in": "query",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "page",
-                        "name": "page",
+                        "type": "string",
+                        "description": "comma separated response fields",
+                        "name": "fields",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "limit",
-                        "name": "limit",
+                        "type": "string",
+                        "default": "all",
+                        "description": "channel videos (none, latest:N, all)",
+                        "name": "videos",
                         "in": "query"
                     }
                 ],
@@ -2328,6 +3496,9 @@ const docTemplate = `{
                                             "items": {
                                                 "$ref": "#/definitions/service.vtuber"
                                             }
+                                        },
+                                        "meta": {
+                                            "$ref": "#/definitions/service.batch"
                                         }
                                     }
                                 }
@@ -2349,7 +3520,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/vtubers/2d-modelers": {
+        "/vtubers/character-designers": {
             "get": {
                 "produces": [
                     "application/json"
@@ -2357,7 +3528,7 @@ const docTemplate = `{
                 "tags": [
                     "Vtuber"
                 ],
-                "summary": "Get vtuber character 2D modelers.",
+                "summary": "Get vtuber character designers.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2389,7 +3560,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/vtubers/3d-modelers": {
+        "/vtubers/family-trees": {
             "get": {
                 "produces": [
                     "application/json"
@@ -2397,7 +3568,58 @@ const docTemplate = `{
                 "tags": [
                     "Vtuber"
                 ],
-                "summary": "Get vtuber character 3D modelers.",
+                "summary": "Get vtuber family trees.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/service.vtuberFamilyTree"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/vtubers/images": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Vtuber"
+                ],
+                "summary": "Get all vtuber images.",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "shuffle",
+                        "name": "shuffle",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2412,7 +3634,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "type": "string"
+                                                "$ref": "#/definitions/service.vtuberImage"
                                             }
                                         }
                                     }
@@ -2429,7 +3651,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/vtubers/agency-trees": {
+        "/vtubers/{id}": {
             "get": {
                 "produces": [
                     "application/json"
@@ -2437,7 +3659,35 @@ const docTemplate = `{
                 "tags": [
                     "Vtuber"
                 ],
-                "summary": "Get vtuber agency trees.",
+                "summary": "Get vtuber data.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "wikia id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "as of date (yyyy-mm-dd)",
+                        "name": "as_of",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated response fields",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "all",
+                        "description": "channel videos (none, latest:N, all)",
+                        "name": "videos",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2450,13 +3700,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/service.vtuberAgencyTree"
+                                            "$ref": "#/definitions/service.vtuber"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2466,15 +3728,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/vtubers/character-designers": {
+        "/vtubers/{id}/changes": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Vtuber"
+                    "Change"
+                ],
+                "summary": "Get vtuber field changes.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "wikia id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "field",
+                        "name": "field",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "WIKI",
+                            "OVERRIDE",
+                            "ADMIN"
+                        ],
+                        "type": "string",
+                        "description": "source",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get vtuber character designers.",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2489,7 +3791,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "type": "string"
+                                                "$ref": "#/definitions/service.vtuberChange"
                                             }
                                         }
                                     }
@@ -2497,6 +3799,107 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/vtubers/{id}/channel-history": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Vtuber"
+                ],
+                "summary": "Get vtuber channel histories.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "wikia id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "start date",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "end date",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "DAILY",
+                            "MONTHLY",
+                            "YEARLY"
+                        ],
+                        "type": "string",
+                        "default": "MONTHLY",
+                        "description": "group",
+                        "name": "group",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "MAX",
+                            "LAST",
+                            "AVG",
+                            "SUM"
+                        ],
+                        "type": "string",
+                        "default": "MAX",
+                        "description": "aggregation",
+                        "name": "aggregation",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/service.vtuberChannelHistory"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2506,7 +3909,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/vtubers/family-trees": {
+        "/vtubers/{id}/forecast": {
             "get": {
                 "produces": [
                     "application/json"
@@ -2514,7 +3917,41 @@ const docTemplate = `{
                 "tags": [
                     "Vtuber"
                 ],
-                "summary": "Get vtuber family trees.",
+                "summary": "Get vtuber channel subscriber forecast.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "wikia id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "LINEAR",
+                            "LOG_LINEAR"
+                        ],
+                        "type": "string",
+                        "default": "LINEAR",
+                        "description": "model",
+                        "name": "model",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 90,
+                        "description": "history days used to fit the model",
+                        "name": "history_days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "projected days",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2527,13 +3964,34 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/service.vtuberFamilyTree"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.vtuberForecast"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2543,24 +4001,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/vtubers/images": {
+        "/vtubers/{id}/milestones": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Vtuber"
+                    "Milestone"
                 ],
-                "summary": "Get all vtuber images.",
+                "summary": "Get vtuber channel subscriber milestones.",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "shuffle",
-                        "name": "shuffle",
+                        "type": "integer",
+                        "description": "wikia id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "YOUTUBE",
+                            "TWITCH",
+                            "BILIBILI",
+                            "NICONICO"
+                        ],
+                        "type": "string",
+                        "description": "channel type",
+                        "name": "channel_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "page",
+                        "name": "page",
                         "in": "query"
                     },
                     {
                         "type": "integer",
+                        "default": 20,
                         "description": "limit",
                         "name": "limit",
                         "in": "query"
@@ -2580,7 +4059,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/service.vtuberImage"
+                                                "$ref": "#/definitions/service.milestone"
                                             }
                                         }
                                     }
@@ -2588,6 +4067,12 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2597,7 +4082,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/vtubers/{id}": {
+        "/vtubers/{id}/similar": {
             "get": {
                 "produces": [
                     "application/json"
@@ -2605,7 +4090,7 @@ const docTemplate = `{
                 "tags": [
                     "Vtuber"
                 ],
-                "summary": "Get vtuber data.",
+                "summary": "Get similar vtubers.",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2613,6 +4098,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2627,7 +4119,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/service.vtuber"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/service.similarVtuber"
+                                            }
                                         }
                                     }
                                 }
@@ -2655,66 +4150,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/vtubers/{id}/channel-history": {
+        "/websub/youtube": {
             "get": {
                 "produces": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "Vtuber"
+                    "WebSub"
                 ],
-                "summary": "Get vtuber channel histories.",
+                "summary": "Verify youtube websub subscription.",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "wikia id",
-                        "name": "id",
-                        "in": "path",
+                        "enum": [
+                            "subscribe",
+                            "unsubscribe",
+                            "denied"
+                        ],
+                        "type": "string",
+                        "description": "mode",
+                        "name": "hub.mode",
+                        "in": "query",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "start date",
-                        "name": "start_date",
-                        "in": "query"
+                        "description": "topic",
+                        "name": "hub.topic",
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "end date",
-                        "name": "end_date",
+                        "description": "challenge",
+                        "name": "hub.challenge",
                         "in": "query"
                     },
                     {
-                        "enum": [
-                            "DAILY",
-                            "MONTHLY",
-                            "YEARLY"
-                        ],
-                        "type": "string",
-                        "default": "MONTHLY",
-                        "description": "group",
-                        "name": "group",
+                        "type": "integer",
+                        "description": "lease seconds",
+                        "name": "hub.lease_seconds",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/utils.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/service.vtuberChannelHistory"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
+                        "description": "challenge"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2735,6 +4215,46 @@ const docTemplate = `{
                         }
                     }
                 }
+            },
+            "post": {
+                "consumes": [
+                    "text/xml"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebSub"
+                ],
+                "summary": "Receive youtube websub notification.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hmac signature",
+                        "name": "X-Hub-Signature",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Response"
+                        }
+                    }
+                }
             }
         },
         "/wikia/image/{path}": {
@@ -2782,6 +4302,21 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "entity.ActivityStatus": {
+            "type": "string",
+            "enum": [
+                "ACTIVE",
+                "HIATUS",
+                "DORMANT",
+                "RETIRED"
+            ],
+            "x-enum-varnames": [
+                "ActivityActive",
+                "ActivityHiatus",
+                "ActivityDormant",
+                "ActivityRetired"
+            ]
+        },
         "entity.ChannelType": {
             "type": "string",
             "enum": [
@@ -2799,6 +4334,36 @@ const docTemplate = `{
                 "ChannelOther"
             ]
         },
+        "entity.Source": {
+            "type": "string",
+            "enum": [
+                "WIKI",
+                "OVERRIDE",
+                "ADMIN"
+            ],
+            "x-enum-varnames": [
+                "SourceWiki",
+                "SourceOverride",
+                "SourceAdmin"
+            ]
+        },
+        "entity.SuggestionType": {
+            "type": "string",
+            "enum": [
+                "VTUBER",
+                "AGENCY",
+                "CHARACTER_DESIGNER",
+                "CHARACTER_2D_MODELER",
+                "CHARACTER_3D_MODELER"
+            ],
+            "x-enum-varnames": [
+                "SuggestionVtuber",
+                "SuggestionAgency",
+                "SuggestionCharacterDesigner",
+                "SuggestionCharacter2DModeler",
+                "SuggestionCharacter3DModeler"
+            ]
+        },
         "service.AuthCallback": {
             "type": "object",
             "required": [
@@ -2852,25 +4417,106 @@ const docTemplate = `{
                 }
             }
         },
-        "service.agency": {
+        "service.agency": {
+            "type": "object",
+            "properties": {
+                "bilibili_subscriber": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "member": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "niconico_subscriber": {
+                    "type": "integer"
+                },
+                "subscriber": {
+                    "type": "integer"
+                },
+                "total_subscriber": {
+                    "type": "integer"
+                },
+                "twitch_subscriber": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "youtube_subscriber": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service.batch": {
+            "type": "object",
+            "properties": {
+                "missing_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "service.channelStatsAnomaly": {
+            "type": "object",
+            "properties": {
+                "channel_id": {
+                    "type": "string"
+                },
+                "channel_type": {
+                    "$ref": "#/definitions/entity.ChannelType"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "subscriber": {
+                    "type": "integer"
+                },
+                "vtuber_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service.failingChannel": {
             "type": "object",
             "properties": {
-                "id": {
+                "channel_id": {
+                    "type": "string"
+                },
+                "channel_name": {
+                    "type": "string"
+                },
+                "channel_type": {
+                    "$ref": "#/definitions/entity.ChannelType"
+                },
+                "channel_url": {
+                    "type": "string"
+                },
+                "fail_count": {
                     "type": "integer"
                 },
-                "image": {
+                "last_error": {
                     "type": "string"
                 },
-                "member": {
+                "last_error_code": {
                     "type": "integer"
                 },
-                "name": {
+                "last_success_at": {
                     "type": "string"
                 },
-                "subscriber": {
+                "vtuber_id": {
                     "type": "integer"
                 },
-                "updated_at": {
+                "vtuber_name": {
                     "type": "string"
                 }
             }
@@ -2888,6 +4534,45 @@ const docTemplate = `{
                 "familyTree3DModeler"
             ]
         },
+        "service.forecastMilestone": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "milestone": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service.forecastModel": {
+            "type": "string",
+            "enum": [
+                "LINEAR",
+                "LOG_LINEAR"
+            ],
+            "x-enum-varnames": [
+                "forecastLinear",
+                "forecastLogLinear"
+            ]
+        },
+        "service.forecastProjection": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "lower": {
+                    "type": "integer"
+                },
+                "subscriber": {
+                    "type": "integer"
+                },
+                "upper": {
+                    "type": "integer"
+                }
+            }
+        },
         "service.language": {
             "type": "object",
             "properties": {
@@ -2899,6 +4584,38 @@ const docTemplate = `{
                 }
             }
         },
+        "service.milestone": {
+            "type": "object",
+            "properties": {
+                "channel_id": {
+                    "type": "string"
+                },
+                "channel_name": {
+                    "type": "string"
+                },
+                "channel_type": {
+                    "$ref": "#/definitions/entity.ChannelType"
+                },
+                "milestone": {
+                    "type": "integer"
+                },
+                "reached_at": {
+                    "type": "string"
+                },
+                "subscriber": {
+                    "type": "integer"
+                },
+                "vtuber_id": {
+                    "type": "integer"
+                },
+                "vtuber_image": {
+                    "type": "string"
+                },
+                "vtuber_name": {
+                    "type": "string"
+                }
+            }
+        },
         "service.nonVtuber": {
             "type": "object",
             "properties": {
@@ -2966,6 +4683,77 @@ const docTemplate = `{
                 }
             }
         },
+        "service.similarScore": {
+            "type": "object",
+            "properties": {
+                "agency": {
+                    "type": "number"
+                },
+                "creator": {
+                    "type": "number"
+                },
+                "debut": {
+                    "type": "number"
+                },
+                "language": {
+                    "type": "number"
+                },
+                "streaming_hour": {
+                    "type": "number"
+                },
+                "subscriber": {
+                    "type": "number"
+                }
+            }
+        },
+        "service.similarVtuber": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "scores": {
+                    "$ref": "#/definitions/service.similarScore"
+                }
+            }
+        },
+        "service.subscriberGrowth": {
+            "type": "object",
+            "properties": {
+                "percent": {
+                    "type": "number"
+                },
+                "subscriber": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service.suggestion": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.SuggestionType"
+                }
+            }
+        },
         "service.video": {
             "type": "object",
             "properties": {
@@ -2981,6 +4769,9 @@ const docTemplate = `{
                 "channel_url": {
                     "type": "string"
                 },
+                "video_category": {
+                    "type": "string"
+                },
                 "video_end_date": {
                     "type": "string"
                 },
@@ -2990,6 +4781,12 @@ const docTemplate = `{
                 "video_image": {
                     "type": "string"
                 },
+                "video_is_recurring": {
+                    "type": "boolean"
+                },
+                "video_scheduled_end_date": {
+                    "type": "string"
+                },
                 "video_start_date": {
                     "type": "string"
                 },
@@ -3013,6 +4810,9 @@ const docTemplate = `{
         "service.vtuber": {
             "type": "object",
             "properties": {
+                "activity_status": {
+                    "$ref": "#/definitions/entity.ActivityStatus"
+                },
                 "affiliations": {
                     "type": "array",
                     "items": {
@@ -3031,6 +4831,9 @@ const docTemplate = `{
                 "average_video_length": {
                     "type": "integer"
                 },
+                "bilibili_subscriber": {
+                    "type": "integer"
+                },
                 "birthday": {
                     "type": "string"
                 },
@@ -3094,6 +4897,9 @@ const docTemplate = `{
                         "$ref": "#/definitions/service.vtuberLanguage"
                     }
                 },
+                "last_activity_date": {
+                    "type": "string"
+                },
                 "monthly_subscriber": {
                     "type": "integer"
                 },
@@ -3106,6 +4912,9 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "niconico_subscriber": {
+                    "type": "integer"
+                },
                 "official_websites": {
                     "type": "array",
                     "items": {
@@ -3130,9 +4939,15 @@ const docTemplate = `{
                 "subscriber": {
                     "type": "integer"
                 },
+                "total_subscriber": {
+                    "type": "integer"
+                },
                 "total_video_length": {
                     "type": "integer"
                 },
+                "twitch_subscriber": {
+                    "type": "integer"
+                },
                 "updated_at": {
                     "type": "string"
                 },
@@ -3142,11 +4957,28 @@ const docTemplate = `{
                 "weight": {
                     "type": "number"
                 },
+                "youtube_subscriber": {
+                    "type": "integer"
+                },
                 "zodiac_sign": {
                     "type": "string"
                 }
             }
         },
+        "service.vtuberActivityCount": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "integer"
+                },
+                "dormant": {
+                    "type": "integer"
+                },
+                "hiatus": {
+                    "type": "integer"
+                }
+            }
+        },
         "service.vtuberAgency": {
             "type": "object",
             "required": [
@@ -3242,6 +5074,32 @@ const docTemplate = `{
                 }
             }
         },
+        "service.vtuberChange": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "field": {
+                    "type": "string"
+                },
+                "new_value": {
+                    "type": "string"
+                },
+                "old_value": {
+                    "type": "string"
+                },
+                "source": {
+                    "$ref": "#/definitions/entity.Source"
+                },
+                "vtuber_id": {
+                    "type": "integer"
+                },
+                "vtuber_name": {
+                    "type": "string"
+                }
+            }
+        },
         "service.vtuberChannel": {
             "type": "object",
             "required": [
@@ -3254,6 +5112,12 @@ const docTemplate = `{
                 "image": {
                     "type": "string"
                 },
+                "is_live": {
+                    "type": "boolean"
+                },
+                "is_stale": {
+                    "type": "boolean"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -3289,9 +5153,18 @@ const docTemplate = `{
                 "month": {
                     "type": "integer"
                 },
+                "stream_hours": {
+                    "type": "number"
+                },
                 "subscriber": {
                     "type": "integer"
                 },
+                "video_count": {
+                    "type": "integer"
+                },
+                "view_count": {
+                    "type": "integer"
+                },
                 "year": {
                     "type": "integer"
                 }
@@ -3384,6 +5257,41 @@ const docTemplate = `{
                 }
             }
         },
+        "service.vtuberForecast": {
+            "type": "object",
+            "properties": {
+                "channel_id": {
+                    "type": "string"
+                },
+                "channel_type": {
+                    "$ref": "#/definitions/entity.ChannelType"
+                },
+                "daily_growth": {
+                    "type": "number"
+                },
+                "data_points": {
+                    "type": "integer"
+                },
+                "model": {
+                    "$ref": "#/definitions/service.forecastModel"
+                },
+                "next_milestone": {
+                    "$ref": "#/definitions/service.forecastMilestone"
+                },
+                "projections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/service.forecastProjection"
+                    }
+                },
+                "r_squared": {
+                    "type": "number"
+                },
+                "subscriber": {
+                    "type": "integer"
+                }
+            }
+        },
         "service.vtuberGenderCount": {
             "type": "object",
             "properties": {
@@ -3468,6 +5376,9 @@ const docTemplate = `{
                 "active": {
                     "type": "integer"
                 },
+                "activity": {
+                    "$ref": "#/definitions/service.vtuberActivityCount"
+                },
                 "retired": {
                     "type": "integer"
                 }
@@ -3487,9 +5398,47 @@ const docTemplate = `{
                 }
             }
         },
+        "service.vtuberSubscriberGrowth": {
+            "type": "object",
+            "properties": {
+                "channel_id": {
+                    "type": "string"
+                },
+                "channel_name": {
+                    "type": "string"
+                },
+                "channel_type": {
+                    "$ref": "#/definitions/entity.ChannelType"
+                },
+                "growth_30d": {
+                    "$ref": "#/definitions/service.subscriberGrowth"
+                },
+                "growth_365d": {
+                    "$ref": "#/definitions/service.subscriberGrowth"
+                },
+                "growth_7d": {
+                    "$ref": "#/definitions/service.subscriberGrowth"
+                },
+                "subscriber": {
+                    "type": "integer"
+                },
+                "vtuber_id": {
+                    "type": "integer"
+                },
+                "vtuber_image": {
+                    "type": "string"
+                },
+                "vtuber_name": {
+                    "type": "string"
+                }
+            }
+        },
         "service.vtuberVideo": {
             "type": "object",
             "properties": {
+                "category": {
+                    "type": "string"
+                },
                 "end_date": {
                     "type": "string"
                 },
@@ -3499,6 +5448,12 @@ const docTemplate = `{
                 "image": {
                     "type": "string"
                 },
+                "is_recurring": {
+                    "type": "boolean"
+                },
+                "scheduled_end_date": {
+                    "type": "string"
+                },
                 "start_date": {
                     "type": "string"
                 },
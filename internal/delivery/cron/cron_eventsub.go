@@ -0,0 +1,40 @@
+package cron
+
+import (
+	"context"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+// EventSub to subscribe eventsub.
+func (c *Cron) EventSub() error {
+	ctx := stack.Init(context.Background())
+	defer c.log(ctx)
+
+	tx := c.nrApp.StartTransaction("Cron eventsub")
+	defer tx.End()
+
+	ctx = newrelic.NewContext(ctx, tx)
+
+	if err := c.subscribeTwitchEventSub(ctx); err != nil {
+		return stack.Wrap(ctx, err)
+	}
+
+	return nil
+}
+
+func (c *Cron) subscribeTwitchEventSub(ctx context.Context) error {
+	defer newrelic.FromContext(ctx).StartSegment("subscribeTwitchEventSub").End()
+
+	cnt, _, err := c.service.SubscribeTwitchEventSub(ctx)
+	if err != nil {
+		return stack.Wrap(ctx, err)
+	}
+
+	utils.Info("subscribed %d twitch eventsub", cnt)
+	c.nrApp.RecordCustomEvent("SubscribeTwitchEventSub", map[string]interface{}{"count": cnt})
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package cron
+
+import (
+	"context"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+// Rollup to roll up old channel stats history.
+func (c *Cron) Rollup(rawAge, dailyAge int) error {
+	ctx := stack.Init(context.Background())
+	defer c.log(ctx)
+
+	tx := c.nrApp.StartTransaction("Cron rollup")
+	defer tx.End()
+
+	ctx = newrelic.NewContext(ctx, tx)
+
+	if err := c.rollupChannelStats(ctx, rawAge, dailyAge); err != nil {
+		return stack.Wrap(ctx, err)
+	}
+
+	return nil
+}
+
+func (c *Cron) rollupChannelStats(ctx context.Context, rawAge, dailyAge int) error {
+	defer newrelic.FromContext(ctx).StartSegment("rollupChannelStats").End()
+
+	cnt, _, err := c.service.RollupChannelStats(ctx, rawAge, dailyAge)
+	if err != nil {
+		return stack.Wrap(ctx, err)
+	}
+
+	utils.Info("rolled up %d channel stats", cnt)
+	c.nrApp.RecordCustomEvent("RollupChannelStats", map[string]interface{}{"count": cnt})
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package cron
+
+import (
+	"context"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/service"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+// Similar to precompute similar vtubers.
+func (c *Cron) Similar(data service.UpdateSimilarVtubersRequest) error {
+	ctx := stack.Init(context.Background())
+	defer c.log(ctx)
+
+	tx := c.nrApp.StartTransaction("Cron similar")
+	defer tx.End()
+
+	ctx = newrelic.NewContext(ctx, tx)
+
+	if err := c.updateSimilarVtubers(ctx, data); err != nil {
+		return stack.Wrap(ctx, err)
+	}
+
+	return nil
+}
+
+func (c *Cron) updateSimilarVtubers(ctx context.Context, data service.UpdateSimilarVtubersRequest) error {
+	defer newrelic.FromContext(ctx).StartSegment("updateSimilarVtubers").End()
+
+	cnt, _, err := c.service.UpdateSimilarVtubers(ctx, data)
+	if err != nil {
+		return stack.Wrap(ctx, err)
+	}
+
+	utils.Info("updated %d similar vtubers", cnt)
+	c.nrApp.RecordCustomEvent("UpdateSimilarVtubers", map[string]interface{}{"count": cnt})
+
+	return nil
+}
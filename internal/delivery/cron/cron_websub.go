@@ -0,0 +1,40 @@
+package cron
+
+import (
+	"context"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+// WebSub to renew websub subscription.
+func (c *Cron) WebSub() error {
+	ctx := stack.Init(context.Background())
+	defer c.log(ctx)
+
+	tx := c.nrApp.StartTransaction("Cron websub")
+	defer tx.End()
+
+	ctx = newrelic.NewContext(ctx, tx)
+
+	if err := c.renewYoutubeWebSub(ctx); err != nil {
+		return stack.Wrap(ctx, err)
+	}
+
+	return nil
+}
+
+func (c *Cron) renewYoutubeWebSub(ctx context.Context) error {
+	defer newrelic.FromContext(ctx).StartSegment("renewYoutubeWebSub").End()
+
+	cnt, _, err := c.service.RenewYoutubeWebSub(ctx)
+	if err != nil {
+		return stack.Wrap(ctx, err)
+	}
+
+	utils.Info("renewed %d youtube websub", cnt)
+	c.nrApp.RecordCustomEvent("RenewYoutubeWebSub", map[string]interface{}{"count": cnt})
+
+	return nil
+}
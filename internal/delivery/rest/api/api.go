@@ -49,8 +49,13 @@ func (api *API) Register(r chi.Router, nrApp *newrelic.Application) {
 		r.Get("/user", api.jwtAuth(api.handleGetProfile))
 
 		r.Get("/vtubers", api.handleGetVtubers)
+		r.Get("/vtubers/batch", api.handleGetVtubersByIDs)
 		r.Get("/vtubers/{id}", api.handleGetVtuberByID)
 		r.Get("/vtubers/{id}/channel-history", api.handleGetVtuberChannelHistory)
+		r.Get("/vtubers/{id}/forecast", api.handleGetVtuberForecast)
+		r.Get("/vtubers/{id}/similar", api.handleGetVtuberSimilars)
+		r.Get("/vtubers/{id}/changes", api.handleGetVtuberChanges)
+		r.Get("/vtubers/{id}/milestones", api.handleGetVtuberMilestones)
 		r.Get("/vtubers/images", api.handleGetVtuberImages)
 		r.Get("/vtubers/family-trees", api.handleGetVtuberFamilyTrees)
 		r.Get("/vtubers/agency-trees", api.handleGetVtuberAgencyTrees)
@@ -59,12 +64,20 @@ func (api *API) Register(r chi.Router, nrApp *newrelic.Application) {
 		r.Get("/vtubers/character-3d-modelers", api.handleGetVtuberCharacter3DModelers)
 
 		r.Get("/agencies", api.handleGetAgencies)
+		r.Get("/agencies/batch", api.handleGetAgenciesByIDs)
 		r.Get("/agencies/{id}", api.handleGetAgencyByID)
+		r.Get("/agencies/{id}/vtubers", api.handleGetAgencyMembers)
 
 		r.Get("/videos", api.handleGetVideos)
 
+		r.Get("/changes", api.handleGetChanges)
+
+		r.Get("/milestones/recent", api.handleGetRecentMilestones)
+
 		r.Get("/languages", api.handleGetLanguages)
 
+		r.Get("/suggest", api.handleGetSuggestions)
+
 		r.Get("/statistics/vtubers/count", api.handleGetVtuberCount)
 		r.Get("/statistics/vtubers/average-active-time", api.handleGetVtuberAverageActiveTime)
 		r.Get("/statistics/vtubers/status-count", api.handleGetVtuberStatusCount)
@@ -89,9 +102,15 @@ func (api *API) Register(r chi.Router, nrApp *newrelic.Application) {
 		r.Get("/statistics/vtubers/language-count", api.handleGetVtuberLanguageCount)
 		r.Get("/statistics/vtubers/gender-count", api.handleGetVtuberGenderCount)
 		r.Get("/statistics/vtubers/zodiac-count", api.handleGetVtuberZodiacCount)
+		r.Get("/statistics/vtubers/growth", api.handleGetVtuberSubscriberGrowth)
 
 		r.Get("/statistics/agencies/count", api.handleGetAgencyCount)
 
+		r.Get("/websub/youtube", api.handleVerifyYoutubeWebSub)
+		r.Post("/websub/youtube", api.handleYoutubeWebSubNotification)
+
+		r.Post("/eventsub/twitch", api.handleTwitchEventSub)
+
 		r.Delete("/admin/vtubers/{id}", api.jwtAuth(api.adminAuth(api.handleDeleteVtuberByID)))
 		r.Post("/admin/vtubers/{id}/parse", api.jwtAuth(api.adminAuth(api.handleParseVtuberByID)))
 		r.Get("/admin/vtubers/{id}/override", api.jwtAuth(api.adminAuth(api.handleGetVtuberOverriddenField)))
@@ -99,5 +118,9 @@ func (api *API) Register(r chi.Router, nrApp *newrelic.Application) {
 
 		r.Get("/admin/non-vtubers", api.jwtAuth(api.adminAuth(api.handleGetNonVtubers)))
 		r.Delete("/admin/non-vtubers/{id}", api.jwtAuth(api.adminAuth(api.handleDeleteNonVtuberByID)))
+
+		r.Get("/admin/channels/failing", api.jwtAuth(api.adminAuth(api.handleGetFailingChannels)))
+		r.Get("/admin/channels/anomalies", api.jwtAuth(api.adminAuth(api.handleGetChannelStatsAnomalies)))
+
 	})
 }
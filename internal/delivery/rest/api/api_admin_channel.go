@@ -0,0 +1,71 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/service"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+// @summary Get channels failing to be fetched repeatedly.
+// @tags Admin
+// @produce json
+// @param Authorization header string true "Bearer jwt.admin_access.token"
+// @param channel_type query string false "channel type" enums(YOUTUBE,TWITCH,BILIBILI,NICONICO)
+// @param min_fail_count query integer false "min consecutive failure count" default(3)
+// @param page query integer false "page" default(1)
+// @param limit query integer false "limit" default(20)
+// @success 200 {object} utils.Response{data=[]service.failingChannel}
+// @failure 400 {object} utils.Response
+// @failure 401 {object} utils.Response
+// @failure 403 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /admin/channels/failing [get]
+func (api *API) handleGetFailingChannels(w http.ResponseWriter, r *http.Request) {
+	channelType := r.URL.Query().Get("channel_type")
+	minFailCount, _ := strconv.Atoi(r.URL.Query().Get("min_fail_count"))
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	channels, pagination, code, err := api.service.GetFailingChannels(r.Context(), service.GetFailingChannelsRequest{
+		ChannelType:  entity.ChannelType(channelType),
+		MinFailCount: minFailCount,
+		Page:         page,
+		Limit:        limit,
+	})
+
+	utils.ResponseWithJSON(w, code, channels, stack.Wrap(r.Context(), err), pagination)
+}
+
+// @summary Get channel stats flagged as subscriber anomaly.
+// @tags Admin
+// @produce json
+// @param Authorization header string true "Bearer jwt.admin_access.token"
+// @param vtuber_id query integer false "vtuber id"
+// @param channel_type query string false "channel type" enums(YOUTUBE,TWITCH,BILIBILI,NICONICO)
+// @param page query integer false "page" default(1)
+// @param limit query integer false "limit" default(20)
+// @success 200 {object} utils.Response{data=[]service.channelStatsAnomaly}
+// @failure 400 {object} utils.Response
+// @failure 401 {object} utils.Response
+// @failure 403 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /admin/channels/anomalies [get]
+func (api *API) handleGetChannelStatsAnomalies(w http.ResponseWriter, r *http.Request) {
+	vtuberID, _ := strconv.ParseInt(r.URL.Query().Get("vtuber_id"), 10, 64)
+	channelType := r.URL.Query().Get("channel_type")
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	anomalies, pagination, code, err := api.service.GetChannelStatsAnomalies(r.Context(), service.GetChannelStatsAnomaliesRequest{
+		VtuberID:    vtuberID,
+		ChannelType: entity.ChannelType(channelType),
+		Page:        page,
+		Limit:       limit,
+	})
+
+	utils.ResponseWithJSON(w, code, anomalies, stack.Wrap(r.Context(), err), pagination)
+}
@@ -18,6 +18,7 @@ import (
 // @param name query string false "name"
 // @param page query integer false "page" default(1)
 // @param limit query integer false "limit" default(20)
+// @param cursor query string false "cursor of next page, page is ignored and total is not counted if set"
 // @success 200 {object} utils.Response{data=[]service.nonVtuber}
 // @failure 400 {object} utils.Response
 // @failure 401 {object} utils.Response
@@ -28,11 +29,13 @@ func (api *API) handleGetNonVtubers(w http.ResponseWriter, r *http.Request) {
 	name := r.URL.Query().Get("name")
 	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
 	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	cursor := r.URL.Query().Get("cursor")
 
 	nonVtubers, pagination, code, err := api.service.GetNonVtubers(r.Context(), service.GetNonVtubersRequest{
-		Name:  name,
-		Page:  page,
-		Limit: limit,
+		Name:   name,
+		Page:   page,
+		Limit:  limit,
+		Cursor: cursor,
 	})
 
 	utils.ResponseWithJSON(w, code, nonVtubers, stack.Wrap(r.Context(), err), pagination)
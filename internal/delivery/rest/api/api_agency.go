@@ -14,7 +14,7 @@ import (
 // @summary Get agency data.
 // @tags Agency
 // @produce json
-// @param sort query string false "sort" enums(name,-name,member,-member,subscriber,-subscriber) default(name)
+// @param sort query string false "sort" enums(name,-name,member,-member,subscriber,-subscriber,youtube_subscriber,-youtube_subscriber,twitch_subscriber,-twitch_subscriber,bilibili_subscriber,-bilibili_subscriber,niconico_subscriber,-niconico_subscriber,total_subscriber,-total_subscriber) default(name)
 // @param page query integer false "page" default(1)
 // @param limit query integer false "limit" default(20)
 // @success 200 {object} utils.Response{data=[]service.agency}
@@ -39,6 +39,7 @@ func (api *API) handleGetAgencies(w http.ResponseWriter, r *http.Request) {
 // @tags Agency
 // @produce json
 // @param id path integer true "wikia id"
+// @param as_of query string false "as of date (yyyy-mm-dd)"
 // @success 200 {object} utils.Response{data=service.agency}
 // @failure 400 {object} utils.Response
 // @failure 404 {object} utils.Response
@@ -51,6 +52,57 @@ func (api *API) handleGetAgencyByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	agency, code, err := api.service.GetAgencyByID(r.Context(), id)
+	asOf := r.URL.Query().Get("as_of")
+
+	agency, code, err := api.service.GetAgencyByID(r.Context(), service.GetAgencyByIDRequest{
+		ID:   id,
+		AsOf: asOf,
+	})
+
 	utils.ResponseWithJSON(w, code, agency, stack.Wrap(r.Context(), err))
 }
+
+// @summary Get agency data by ids.
+// @tags Agency
+// @produce json
+// @param ids query string true "comma separated wikia ids (max 100)"
+// @success 200 {object} utils.Response{data=[]service.agency,meta=service.batch}
+// @failure 400 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /agencies/batch [get]
+func (api *API) handleGetAgenciesByIDs(w http.ResponseWriter, r *http.Request) {
+	ids := utils.StrToInt64Slice(r.URL.Query().Get("ids"))
+
+	agencies, batch, code, err := api.service.GetAgenciesByIDs(r.Context(), service.GetAgenciesByIDsRequest{
+		IDs: ids,
+	})
+
+	utils.ResponseWithJSON(w, code, agencies, stack.Wrap(r.Context(), err), batch)
+}
+
+// @summary Get agency member list.
+// @tags Agency
+// @produce json
+// @param id path integer true "wikia id"
+// @param as_of query string false "as of date (yyyy-mm-dd)"
+// @success 200 {object} utils.Response{data=[]service.vtuber}
+// @failure 400 {object} utils.Response
+// @failure 404 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /agencies/{id}/vtubers [get]
+func (api *API) handleGetAgencyMembers(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		utils.ResponseWithJSON(w, http.StatusBadRequest, nil, stack.Wrap(r.Context(), err, errors.ErrInvalidID))
+		return
+	}
+
+	asOf := r.URL.Query().Get("as_of")
+
+	vtubers, code, err := api.service.GetAgencyMembers(r.Context(), service.GetAgencyMembersRequest{
+		ID:   id,
+		AsOf: asOf,
+	})
+
+	utils.ResponseWithJSON(w, code, vtubers, stack.Wrap(r.Context(), err))
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/vtuber_change/entity"
+	"github.com/rl404/shimakaze/internal/errors"
+	"github.com/rl404/shimakaze/internal/service"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+// @summary Get vtuber field changes.
+// @tags Change
+// @produce json
+// @param id path integer true "wikia id"
+// @param field query string false "field"
+// @param source query string false "source" enums(WIKI,OVERRIDE,ADMIN)
+// @param page query integer false "page" default(1)
+// @param limit query integer false "limit" default(20)
+// @success 200 {object} utils.Response{data=[]service.vtuberChange}
+// @failure 400 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /vtubers/{id}/changes [get]
+func (api *API) handleGetVtuberChanges(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		utils.ResponseWithJSON(w, http.StatusBadRequest, nil, stack.Wrap(r.Context(), err, errors.ErrInvalidID))
+		return
+	}
+
+	api.getChanges(w, r, id)
+}
+
+// @summary Get all vtuber field changes.
+// @tags Change
+// @produce json
+// @param field query string false "field"
+// @param source query string false "source" enums(WIKI,OVERRIDE,ADMIN)
+// @param page query integer false "page" default(1)
+// @param limit query integer false "limit" default(20)
+// @success 200 {object} utils.Response{data=[]service.vtuberChange}
+// @failure 400 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /changes [get]
+func (api *API) handleGetChanges(w http.ResponseWriter, r *http.Request) {
+	api.getChanges(w, r, 0)
+}
+
+func (api *API) getChanges(w http.ResponseWriter, r *http.Request, id int64) {
+	field := r.URL.Query().Get("field")
+	source := r.URL.Query().Get("source")
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	changes, pagination, code, err := api.service.GetVtuberChanges(r.Context(), service.GetVtuberChangesRequest{
+		ID:     id,
+		Field:  field,
+		Source: entity.Source(source),
+		Page:   page,
+		Limit:  limit,
+	})
+
+	utils.ResponseWithJSON(w, code, changes, stack.Wrap(r.Context(), err), pagination)
+}
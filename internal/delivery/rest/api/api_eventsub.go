@@ -0,0 +1,42 @@
+package api
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/errors"
+	"github.com/rl404/shimakaze/internal/service"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+// @summary Receive twitch eventsub webhook.
+// @tags EventSub
+// @accept json
+// @produce plain
+// @param Twitch-Eventsub-Message-Id header string true "message id"
+// @param Twitch-Eventsub-Message-Type header string true "message type" enums(webhook_callback_verification, notification, revocation)
+// @param Twitch-Eventsub-Message-Timestamp header string true "message timestamp"
+// @param Twitch-Eventsub-Message-Signature header string true "hmac signature"
+// @success 200 "challenge"
+// @failure 400 {object} utils.Response
+// @failure 403 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /eventsub/twitch [post]
+func (api *API) handleTwitchEventSub(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		utils.ResponseWithJSON(w, http.StatusBadRequest, nil, stack.Wrap(r.Context(), err, errors.ErrInvalidRequestFormat))
+		return
+	}
+
+	res, code, err := api.service.HandleTwitchEventSub(r.Context(), service.TwitchEventSubRequest{
+		MessageID:        r.Header.Get("Twitch-Eventsub-Message-Id"),
+		MessageType:      r.Header.Get("Twitch-Eventsub-Message-Type"),
+		MessageTimestamp: r.Header.Get("Twitch-Eventsub-Message-Timestamp"),
+		MessageSignature: r.Header.Get("Twitch-Eventsub-Message-Signature"),
+		Body:             body,
+	})
+
+	utils.ResponseWithText(w, code, res, stack.Wrap(r.Context(), err))
+}
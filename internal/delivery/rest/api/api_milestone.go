@@ -0,0 +1,75 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/errors"
+	"github.com/rl404/shimakaze/internal/service"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+// @summary Get vtuber channel subscriber milestones.
+// @tags Milestone
+// @produce json
+// @param id path integer true "wikia id"
+// @param channel_type query string false "channel type" enums(YOUTUBE,TWITCH,BILIBILI,NICONICO)
+// @param page query integer false "page" default(1)
+// @param limit query integer false "limit" default(20)
+// @success 200 {object} utils.Response{data=[]service.milestone}
+// @failure 400 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /vtubers/{id}/milestones [get]
+func (api *API) handleGetVtuberMilestones(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		utils.ResponseWithJSON(w, http.StatusBadRequest, nil, stack.Wrap(r.Context(), err, errors.ErrInvalidID))
+		return
+	}
+
+	channelType := r.URL.Query().Get("channel_type")
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	milestones, pagination, code, err := api.service.GetVtuberMilestones(r.Context(), service.GetVtuberMilestonesRequest{
+		ID:          id,
+		ChannelType: entity.ChannelType(channelType),
+		Page:        page,
+		Limit:       limit,
+	})
+
+	utils.ResponseWithJSON(w, code, milestones, stack.Wrap(r.Context(), err), pagination)
+}
+
+// @summary Get recent channel subscriber milestones.
+// @tags Milestone
+// @produce json
+// @param channel_type query string false "channel type" enums(YOUTUBE,TWITCH,BILIBILI,NICONICO)
+// @param min_milestone query integer false "min milestone"
+// @param days query integer false "days" default(30)
+// @param page query integer false "page" default(1)
+// @param limit query integer false "limit" default(20)
+// @success 200 {object} utils.Response{data=[]service.milestone}
+// @failure 400 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /milestones/recent [get]
+func (api *API) handleGetRecentMilestones(w http.ResponseWriter, r *http.Request) {
+	channelType := r.URL.Query().Get("channel_type")
+	minMilestone, _ := strconv.Atoi(r.URL.Query().Get("min_milestone"))
+	days, _ := strconv.Atoi(r.URL.Query().Get("days"))
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	milestones, pagination, code, err := api.service.GetRecentMilestones(r.Context(), service.GetRecentMilestonesRequest{
+		ChannelType:  entity.ChannelType(channelType),
+		MinMilestone: minMilestone,
+		Days:         days,
+		Page:         page,
+		Limit:        limit,
+	})
+
+	utils.ResponseWithJSON(w, code, milestones, stack.Wrap(r.Context(), err), pagination)
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
 	"github.com/rl404/shimakaze/internal/service"
 	"github.com/rl404/shimakaze/internal/utils"
 )
@@ -102,6 +103,7 @@ func (api *API) handleGetVtuberInAgencyCount(w http.ResponseWriter, r *http.Requ
 // @produce json
 // @param interval query integer false "interval" default(100000)
 // @param max query integer false "max" default(5000000)
+// @param platform query string false "subscriber platform" enums(YOUTUBE,TWITCH,BILIBILI,NICONICO,TOTAL)
 // @success 200 {object} utils.Response{data=[]service.vtuberSubscriberCount}
 // @failure 400 {object} utils.Response
 // @failure 500 {object} utils.Response
@@ -112,6 +114,7 @@ func (api *API) handleGetVtuberSubscriberCount(w http.ResponseWriter, r *http.Re
 	cnt, code, err := api.service.GetVtuberSubscriberCount(r.Context(), service.GetVtuberSubscriberCountRequest{
 		Interval: interval,
 		Max:      max,
+		Platform: entity.SubscriberPlatform(r.URL.Query().Get("platform")),
 	})
 	utils.ResponseWithJSON(w, code, cnt, stack.Wrap(r.Context(), err))
 }
@@ -314,3 +317,42 @@ func (api *API) handleGetVtuberZodiacCount(w http.ResponseWriter, r *http.Reques
 	cnt, code, err := api.service.GetVtuberZodiacCount(r.Context())
 	utils.ResponseWithJSON(w, code, cnt, stack.Wrap(r.Context(), err))
 }
+
+// @summary Get vtuber channel subscriber growth.
+// @tags Statistic
+// @produce json
+// @param channel_type query string false "channel type" enums(YOUTUBE,TWITCH,BILIBILI,NICONICO)
+// @param agency_id query integer false "agency id"
+// @param language_id query integer false "language id"
+// @param exclude_active query boolean false "exclude active"
+// @param exclude_retired query boolean false "exclude retired"
+// @param sort query string false "sort" enums(subscriber,-subscriber,growth_7d,-growth_7d,growth_30d,-growth_30d,growth_365d,-growth_365d,percent_7d,-percent_7d,percent_30d,-percent_30d,percent_365d,-percent_365d) default(-growth_30d)
+// @param page query integer false "page" default(1)
+// @param limit query integer false "limit" default(20)
+// @success 200 {object} utils.Response{data=[]service.vtuberSubscriberGrowth}
+// @failure 400 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /statistics/vtubers/growth [get]
+func (api *API) handleGetVtuberSubscriberGrowth(w http.ResponseWriter, r *http.Request) {
+	channelType := r.URL.Query().Get("channel_type")
+	agencyID, _ := strconv.ParseInt(r.URL.Query().Get("agency_id"), 10, 64)
+	languageID, _ := strconv.ParseInt(r.URL.Query().Get("language_id"), 10, 64)
+	excludeActive, _ := strconv.ParseBool(r.URL.Query().Get("exclude_active"))
+	excludeRetired, _ := strconv.ParseBool(r.URL.Query().Get("exclude_retired"))
+	sort := r.URL.Query().Get("sort")
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	growths, pagination, code, err := api.service.GetVtuberSubscriberGrowth(r.Context(), service.GetVtuberSubscriberGrowthRequest{
+		ChannelType:    entity.ChannelType(channelType),
+		AgencyID:       agencyID,
+		LanguageID:     languageID,
+		ExcludeActive:  excludeActive,
+		ExcludeRetired: excludeRetired,
+		Sort:           sort,
+		Page:           page,
+		Limit:          limit,
+	})
+
+	utils.ResponseWithJSON(w, code, growths, stack.Wrap(r.Context(), err), pagination)
+}
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/service"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+// @summary Get vtuber, agency, and creator suggestions.
+// @tags Suggest
+// @produce json
+// @param q query string true "query"
+// @param limit query integer false "limit" default(10)
+// @success 200 {object} utils.Response{data=[]service.suggestion}
+// @failure 400 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /suggest [get]
+func (api *API) handleGetSuggestions(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	suggestions, code, err := api.service.GetSuggestions(r.Context(), service.GetSuggestionsRequest{
+		Query: r.URL.Query().Get("q"),
+		Limit: limit,
+	})
+	utils.ResponseWithJSON(w, code, suggestions, stack.Wrap(r.Context(), err))
+}
@@ -18,6 +18,7 @@ import (
 // @param sort query string false "sort" enums(video_start_date,-video_start_date) default(-video_start_date)
 // @param page query integer false "page" default(1)
 // @param limit query integer false "limit" default(20)
+// @param cursor query string false "cursor of next page, page is ignored and total is not counted if set"
 // @success 200 {object} utils.Response{data=[]service.video}
 // @failure 400 {object} utils.Response
 // @failure 500 {object} utils.Response
@@ -29,6 +30,7 @@ func (api *API) handleGetVideos(w http.ResponseWriter, r *http.Request) {
 	sort := r.URL.Query().Get("sort")
 	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
 	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	cursor := r.URL.Query().Get("cursor")
 
 	videos, pagination, code, err := api.service.GetVideos(r.Context(), service.GetVideosRequest{
 		StartDate:  startDate,
@@ -37,6 +39,7 @@ func (api *API) handleGetVideos(w http.ResponseWriter, r *http.Request) {
 		Sort:       sort,
 		Page:       page,
 		Limit:      limit,
+		Cursor:     cursor,
 	})
 
 	utils.ResponseWithJSON(w, code, videos, stack.Wrap(r.Context(), err), pagination)
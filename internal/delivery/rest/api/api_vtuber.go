@@ -17,7 +17,8 @@ import (
 // @tags Vtuber
 // @produce json
 // @param mode query string false "mode" enums(all, simple) default(all)
-// @param names query string false "names"
+// @param query query string false "full-text search query"
+// @param names query string false "names (kana/romaji & width insensitive)"
 // @param name query string false "name"
 // @param original_name query string false "original name"
 // @param nickname query string false "nickname"
@@ -35,13 +36,31 @@ import (
 // @param has_2d query boolean false "has 2d model"
 // @param has_3d query boolean false "has 3d model"
 // @param character_designer query string false "character designer"
+// @param character_designers query string false "comma separated character designers"
+// @param exclude_character_designers query string false "comma separated excluded character designers"
+// @param character_designer_match query string false "character designers match mode" enums(any,all) default(any)
 // @param character_2d_modeler query string false "character 2d modeler"
+// @param character_2d_modelers query string false "comma separated character 2d modelers"
+// @param exclude_character_2d_modelers query string false "comma separated excluded character 2d modelers"
+// @param character_2d_modeler_match query string false "character 2d modelers match mode" enums(any,all) default(any)
 // @param character_3d_modeler query string false "character 3d modeler"
+// @param character_3d_modelers query string false "comma separated character 3d modelers"
+// @param exclude_character_3d_modelers query string false "comma separated excluded character 3d modelers"
+// @param character_3d_modeler_match query string false "character 3d modelers match mode" enums(any,all) default(any)
 // @param in_agency query boolean false "in agency"
 // @param agency query string false "agency"
 // @param agency_id query integer false "agency id"
+// @param agency_ids query string false "comma separated agency ids"
+// @param exclude_agency_ids query string false "comma separated excluded agency ids"
+// @param agency_match query string false "agency ids match mode" enums(any,all) default(any)
 // @param language_id query integer false "language id"
+// @param language_ids query string false "comma separated language ids"
+// @param exclude_language_ids query string false "comma separated excluded language ids"
+// @param language_match query string false "language ids match mode" enums(any,all) default(any)
 // @param channel_types query string false "channel types"
+// @param exclude_channel_types query string false "comma separated excluded channel types"
+// @param channel_type_match query string false "channel types match mode" enums(any,all) default(all)
+// @param activity_statuses query string false "comma separated activity statuses (ACTIVE,HIATUS,DORMANT,RETIRED), prefix with - to exclude"
 // @param birthday_day query integer false "birthday day"
 // @param start_birthday_month query integer false "start birthday month"
 // @param end_birthday_month query integer false "end birthday month"
@@ -50,17 +69,23 @@ import (
 // @param zodiacs query string false "zodiac types"
 // @param start_subscriber query integer false "start subscriber"
 // @param end_subscriber query integer false "end subscriber"
+// @param subscriber_platform query string false "subscriber platform for start/end subscriber filter" enums(YOUTUBE,TWITCH,BILIBILI,NICONICO,TOTAL)
 // @param start_video_count query integer false "start video count"
 // @param end_video_count query integer false "end video count"
-// @param sort query string false "sort" enums(name,-name,debut_date,-debut_date,retirement_date,-retirement_date,subscriber,-subscriber,monthly_subscriber,-monthly_subscriber,video_count,-video_count,average_video_length,-average_video_length,total_video_length,-total_video_length) default(name)
+// @param filter query string false "boolean filter expression, e.g. (agency_id:1 OR agency_id:2) AND NOT has_3d:true AND language_id IN (1,2)"
+// @param sort query string false "sort" enums(relevance,name,-name,debut_date,-debut_date,retirement_date,-retirement_date,subscriber,-subscriber,youtube_subscriber,-youtube_subscriber,twitch_subscriber,-twitch_subscriber,bilibili_subscriber,-bilibili_subscriber,niconico_subscriber,-niconico_subscriber,total_subscriber,-total_subscriber,monthly_subscriber,-monthly_subscriber,video_count,-video_count,average_video_length,-average_video_length,total_video_length,-total_video_length,activity_status,-activity_status,last_activity_date,-last_activity_date) default(name)
 // @param page query integer false "page" default(1)
 // @param limit query integer false "limit" default(20)
+// @param cursor query string false "cursor of next page, page is ignored and total is not counted if set"
+// @param fields query string false "comma separated response fields"
+// @param videos query string false "channel videos (none, latest:N, all)" default(all)
 // @success 200 {object} utils.Response{data=[]service.vtuber}
 // @failure 400 {object} utils.Response
 // @failure 500 {object} utils.Response
 // @router /vtubers [get]
 func (api *API) handleGetVtubers(w http.ResponseWriter, r *http.Request) {
 	mode := r.URL.Query().Get("mode")
+	query := r.URL.Query().Get("query")
 	names := r.URL.Query().Get("names")
 	name := r.URL.Query().Get("name")
 	originalName := r.URL.Query().Get("original_name")
@@ -79,13 +104,31 @@ func (api *API) handleGetVtubers(w http.ResponseWriter, r *http.Request) {
 	has2D := utils.StrToPtrBool(r.URL.Query().Get("has_2d"))
 	has3D := utils.StrToPtrBool(r.URL.Query().Get("has_3d"))
 	characterDesigner := r.URL.Query().Get("character_designer")
+	characterDesigners := utils.StrToStrSlice(r.URL.Query().Get("character_designers"))
+	excludeCharacterDesigners := utils.StrToStrSlice(r.URL.Query().Get("exclude_character_designers"))
+	characterDesignerMatch := r.URL.Query().Get("character_designer_match")
 	character2DModeler := r.URL.Query().Get("character_2d_modeler")
+	character2DModelers := utils.StrToStrSlice(r.URL.Query().Get("character_2d_modelers"))
+	excludeCharacter2DModelers := utils.StrToStrSlice(r.URL.Query().Get("exclude_character_2d_modelers"))
+	character2DModelerMatch := r.URL.Query().Get("character_2d_modeler_match")
 	character3DModeler := r.URL.Query().Get("character_3d_modeler")
+	character3DModelers := utils.StrToStrSlice(r.URL.Query().Get("character_3d_modelers"))
+	excludeCharacter3DModelers := utils.StrToStrSlice(r.URL.Query().Get("exclude_character_3d_modelers"))
+	character3DModelerMatch := r.URL.Query().Get("character_3d_modeler_match")
 	inAgency := utils.StrToPtrBool(r.URL.Query().Get("in_agency"))
 	agency := r.URL.Query().Get("agency")
 	agencyID, _ := strconv.ParseInt(r.URL.Query().Get("agency_id"), 10, 64)
+	agencyIDs := utils.StrToInt64Slice(r.URL.Query().Get("agency_ids"))
+	excludeAgencyIDs := utils.StrToInt64Slice(r.URL.Query().Get("exclude_agency_ids"))
+	agencyMatch := r.URL.Query().Get("agency_match")
 	languageID, _ := strconv.ParseInt(r.URL.Query().Get("language_id"), 10, 64)
+	languageIDs := utils.StrToInt64Slice(r.URL.Query().Get("language_ids"))
+	excludeLanguageIDs := utils.StrToInt64Slice(r.URL.Query().Get("exclude_language_ids"))
+	languageMatch := r.URL.Query().Get("language_match")
 	channelTypes := utils.StrToStrSlice(r.URL.Query().Get("channel_types"))
+	excludeChannelTypes := utils.StrToStrSlice(r.URL.Query().Get("exclude_channel_types"))
+	channelTypeMatch := r.URL.Query().Get("channel_type_match")
+	activityStatuses := utils.StrToStrSlice(r.URL.Query().Get("activity_statuses"))
 	birthdayDay, _ := strconv.Atoi(r.URL.Query().Get("birthday_day"))
 	startBirthdayMonth, _ := strconv.Atoi(r.URL.Query().Get("start_birthday_month"))
 	endBirthdayMonth, _ := strconv.Atoi(r.URL.Query().Get("end_birthday_month"))
@@ -96,50 +139,78 @@ func (api *API) handleGetVtubers(w http.ResponseWriter, r *http.Request) {
 	endSubscriber, _ := strconv.Atoi(r.URL.Query().Get("end_subscriber"))
 	startVideoCount, _ := strconv.Atoi(r.URL.Query().Get("start_video_count"))
 	endVideoCount, _ := strconv.Atoi(r.URL.Query().Get("end_video_count"))
+	filter := r.URL.Query().Get("filter")
 	sort := r.URL.Query().Get("sort")
 	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
 	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	cursor := r.URL.Query().Get("cursor")
+	fields := utils.StrToStrSlice(r.URL.Query().Get("fields"))
+	videos := r.URL.Query().Get("videos")
 
 	vtubers, pagination, code, err := api.service.GetVtubers(r.Context(), service.GetVtubersRequest{
-		Mode:               entity.SearchMode(mode),
-		Names:              names,
-		Name:               name,
-		OriginalName:       originalName,
-		Nickname:           nickname,
-		ExcludeActive:      excludeActive,
-		ExcludeRetired:     excludeRetired,
-		DebutDay:           debutDay,
-		StartDebutMonth:    startDebutMonth,
-		EndDebutMonth:      endDebutMonth,
-		StartDebutYear:     startDebutYear,
-		EndDebutYear:       endDebutYear,
-		StartRetiredMonth:  startRetiredMonth,
-		EndRetiredMonth:    endRetiredMonth,
-		StartRetiredYear:   startRetiredYear,
-		EndRetiredYear:     endRetiredYear,
-		Has2D:              has2D,
-		Has3D:              has3D,
-		CharacterDesigner:  characterDesigner,
-		Character2DModeler: character2DModeler,
-		Character3DModeler: character3DModeler,
-		InAgency:           inAgency,
-		Agency:             agency,
-		AgencyID:           agencyID,
-		LanguageID:         languageID,
-		ChannelTypes:       entity.StrsToChannelTypes(channelTypes),
-		BirthdayDay:        birthdayDay,
-		StartBirthdayMonth: startBirthdayMonth,
-		EndBirthdayMonth:   endBirthdayMonth,
-		BloodTypes:         bloodTypes,
-		Genders:            genders,
-		Zodiacs:            zodiacs,
-		StartSubscriber:    startSubscriber,
-		EndSubscriber:      endSubscriber,
-		StartVideoCount:    startVideoCount,
-		EndVideoCount:      endVideoCount,
-		Sort:               sort,
-		Page:               page,
-		Limit:              limit,
+		Mode:                       entity.SearchMode(mode),
+		Query:                      query,
+		Names:                      names,
+		Name:                       name,
+		OriginalName:               originalName,
+		Nickname:                   nickname,
+		ExcludeActive:              excludeActive,
+		ExcludeRetired:             excludeRetired,
+		DebutDay:                   debutDay,
+		StartDebutMonth:            startDebutMonth,
+		EndDebutMonth:              endDebutMonth,
+		StartDebutYear:             startDebutYear,
+		EndDebutYear:               endDebutYear,
+		StartRetiredMonth:          startRetiredMonth,
+		EndRetiredMonth:            endRetiredMonth,
+		StartRetiredYear:           startRetiredYear,
+		EndRetiredYear:             endRetiredYear,
+		Has2D:                      has2D,
+		Has3D:                      has3D,
+		CharacterDesigner:          characterDesigner,
+		CharacterDesigners:         characterDesigners,
+		ExcludeCharacterDesigners:  excludeCharacterDesigners,
+		CharacterDesignerMatch:     entity.MatchMode(characterDesignerMatch),
+		Character2DModeler:         character2DModeler,
+		Character2DModelers:        character2DModelers,
+		ExcludeCharacter2DModelers: excludeCharacter2DModelers,
+		Character2DModelerMatch:    entity.MatchMode(character2DModelerMatch),
+		Character3DModeler:         character3DModeler,
+		Character3DModelers:        character3DModelers,
+		ExcludeCharacter3DModelers: excludeCharacter3DModelers,
+		Character3DModelerMatch:    entity.MatchMode(character3DModelerMatch),
+		InAgency:                   inAgency,
+		Agency:                     agency,
+		AgencyID:                   agencyID,
+		AgencyIDs:                  agencyIDs,
+		ExcludeAgencyIDs:           excludeAgencyIDs,
+		AgencyMatch:                entity.MatchMode(agencyMatch),
+		LanguageID:                 languageID,
+		LanguageIDs:                languageIDs,
+		ExcludeLanguageIDs:         excludeLanguageIDs,
+		LanguageMatch:              entity.MatchMode(languageMatch),
+		ChannelTypes:               entity.StrsToChannelTypes(channelTypes),
+		ExcludeChannelTypes:        entity.StrsToChannelTypes(excludeChannelTypes),
+		ChannelTypeMatch:           entity.MatchMode(channelTypeMatch),
+		ActivityStatuses:           entity.StrsToActivityStatuses(activityStatuses),
+		BirthdayDay:                birthdayDay,
+		StartBirthdayMonth:         startBirthdayMonth,
+		EndBirthdayMonth:           endBirthdayMonth,
+		BloodTypes:                 bloodTypes,
+		Genders:                    genders,
+		Zodiacs:                    zodiacs,
+		StartSubscriber:            startSubscriber,
+		EndSubscriber:              endSubscriber,
+		SubscriberPlatform:         entity.SubscriberPlatform(r.URL.Query().Get("subscriber_platform")),
+		StartVideoCount:            startVideoCount,
+		EndVideoCount:              endVideoCount,
+		Filter:                     filter,
+		Sort:                       sort,
+		Page:                       page,
+		Limit:                      limit,
+		Cursor:                     cursor,
+		Fields:                     fields,
+		Videos:                     videos,
 	})
 
 	utils.ResponseWithJSON(w, code, vtubers, stack.Wrap(r.Context(), err), pagination)
@@ -149,6 +220,9 @@ func (api *API) handleGetVtubers(w http.ResponseWriter, r *http.Request) {
 // @tags Vtuber
 // @produce json
 // @param id path integer true "wikia id"
+// @param as_of query string false "as of date (yyyy-mm-dd)"
+// @param fields query string false "comma separated response fields"
+// @param videos query string false "channel videos (none, latest:N, all)" default(all)
 // @success 200 {object} utils.Response{data=service.vtuber}
 // @failure 400 {object} utils.Response
 // @failure 404 {object} utils.Response
@@ -161,10 +235,43 @@ func (api *API) handleGetVtuberByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	vtuber, code, err := api.service.GetVtuberByID(r.Context(), id)
+	asOf := r.URL.Query().Get("as_of")
+	fields := utils.StrToStrSlice(r.URL.Query().Get("fields"))
+	videos := r.URL.Query().Get("videos")
+
+	vtuber, code, err := api.service.GetVtuberByID(r.Context(), service.GetVtuberByIDRequest{
+		ID:     id,
+		AsOf:   asOf,
+		Fields: fields,
+		Videos: videos,
+	})
 	utils.ResponseWithJSON(w, code, vtuber, stack.Wrap(r.Context(), err))
 }
 
+// @summary Get vtuber data by ids.
+// @tags Vtuber
+// @produce json
+// @param ids query string true "comma separated wikia ids (max 100)"
+// @param fields query string false "comma separated response fields"
+// @param videos query string false "channel videos (none, latest:N, all)" default(all)
+// @success 200 {object} utils.Response{data=[]service.vtuber,meta=service.batch}
+// @failure 400 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /vtubers/batch [get]
+func (api *API) handleGetVtubersByIDs(w http.ResponseWriter, r *http.Request) {
+	ids := utils.StrToInt64Slice(r.URL.Query().Get("ids"))
+	fields := utils.StrToStrSlice(r.URL.Query().Get("fields"))
+	videos := r.URL.Query().Get("videos")
+
+	vtubers, batch, code, err := api.service.GetVtubersByIDs(r.Context(), service.GetVtubersByIDsRequest{
+		IDs:    ids,
+		Fields: fields,
+		Videos: videos,
+	})
+
+	utils.ResponseWithJSON(w, code, vtubers, stack.Wrap(r.Context(), err), batch)
+}
+
 // @summary Get vtuber channel histories.
 // @tags Vtuber
 // @produce json
@@ -172,6 +279,7 @@ func (api *API) handleGetVtuberByID(w http.ResponseWriter, r *http.Request) {
 // @param start_date query string false "start date"
 // @param end_date query string false "end date"
 // @param group query string false "group" enums(DAILY,MONTHLY,YEARLY) default(MONTHLY)
+// @param aggregation query string false "aggregation" enums(MAX,LAST,AVG,SUM) default(MAX)
 // @success 200 {object} utils.Response{data=service.vtuberChannelHistory}
 // @failure 400 {object} utils.Response
 // @failure 404 {object} utils.Response
@@ -187,17 +295,80 @@ func (api *API) handleGetVtuberChannelHistory(w http.ResponseWriter, r *http.Req
 	startDate := r.URL.Query().Get("start_date")
 	endDate := r.URL.Query().Get("end_date")
 	group := r.URL.Query().Get("group")
+	aggregation := r.URL.Query().Get("aggregation")
 
 	histories, code, err := api.service.GetVtuberChannelHistoriesByID(r.Context(), service.GetVtuberChannelHistoriesRequest{
-		ID:        id,
-		StartDate: startDate,
-		EndDate:   endDate,
-		Group:     historyEntity.Group(group),
+		ID:          id,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Group:       historyEntity.Group(group),
+		Aggregation: historyEntity.Aggregation(aggregation),
 	})
 
 	utils.ResponseWithJSON(w, code, histories, stack.Wrap(r.Context(), err))
 }
 
+// @summary Get vtuber channel subscriber forecast.
+// @tags Vtuber
+// @produce json
+// @param id path integer true "wikia id"
+// @param model query string false "model" enums(LINEAR,LOG_LINEAR) default(LINEAR)
+// @param history_days query integer false "history days used to fit the model" default(90)
+// @param days query integer false "projected days" default(30)
+// @success 200 {object} utils.Response{data=[]service.vtuberForecast}
+// @failure 400 {object} utils.Response
+// @failure 404 {object} utils.Response
+// @failure 422 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /vtubers/{id}/forecast [get]
+func (api *API) handleGetVtuberForecast(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		utils.ResponseWithJSON(w, http.StatusBadRequest, nil, stack.Wrap(r.Context(), err, errors.ErrInvalidID))
+		return
+	}
+
+	model := r.URL.Query().Get("model")
+	historyDays, _ := strconv.Atoi(r.URL.Query().Get("history_days"))
+	days, _ := strconv.Atoi(r.URL.Query().Get("days"))
+
+	forecasts, code, err := api.service.GetVtuberForecast(r.Context(), service.GetVtuberForecastRequest{
+		ID:          id,
+		Model:       model,
+		HistoryDays: historyDays,
+		Days:        days,
+	})
+
+	utils.ResponseWithJSON(w, code, forecasts, stack.Wrap(r.Context(), err))
+}
+
+// @summary Get similar vtubers.
+// @tags Vtuber
+// @produce json
+// @param id path integer true "wikia id"
+// @param limit query integer false "limit" default(10)
+// @success 200 {object} utils.Response{data=[]service.similarVtuber}
+// @failure 400 {object} utils.Response
+// @failure 404 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /vtubers/{id}/similar [get]
+func (api *API) handleGetVtuberSimilars(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		utils.ResponseWithJSON(w, http.StatusBadRequest, nil, stack.Wrap(r.Context(), err, errors.ErrInvalidID))
+		return
+	}
+
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	similars, code, err := api.service.GetVtuberSimilars(r.Context(), service.GetVtuberSimilarsRequest{
+		ID:    id,
+		Limit: limit,
+	})
+
+	utils.ResponseWithJSON(w, code, similars, stack.Wrap(r.Context(), err))
+}
+
 // @summary Get all vtuber images.
 // @tags Vtuber
 // @produce json
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/errors"
+	"github.com/rl404/shimakaze/internal/service"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+// @summary Verify youtube websub subscription.
+// @tags WebSub
+// @produce plain
+// @param hub.mode query string true "mode" enums(subscribe, unsubscribe, denied)
+// @param hub.topic query string true "topic"
+// @param hub.challenge query string false "challenge"
+// @param hub.lease_seconds query integer false "lease seconds"
+// @success 200 "challenge"
+// @failure 400 {object} utils.Response
+// @failure 404 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /websub/youtube [get]
+func (api *API) handleVerifyYoutubeWebSub(w http.ResponseWriter, r *http.Request) {
+	mode := r.URL.Query().Get("hub.mode")
+	topic := r.URL.Query().Get("hub.topic")
+	challenge := r.URL.Query().Get("hub.challenge")
+	leaseSeconds, _ := strconv.Atoi(r.URL.Query().Get("hub.lease_seconds"))
+
+	res, code, err := api.service.VerifyYoutubeWebSub(r.Context(), service.VerifyYoutubeWebSubRequest{
+		Mode:         mode,
+		Topic:        topic,
+		Challenge:    challenge,
+		LeaseSeconds: leaseSeconds,
+	})
+
+	utils.ResponseWithText(w, code, res, stack.Wrap(r.Context(), err))
+}
+
+// @summary Receive youtube websub notification.
+// @tags WebSub
+// @accept xml
+// @produce json
+// @param X-Hub-Signature header string false "hmac signature"
+// @success 200 {object} utils.Response
+// @failure 400 {object} utils.Response
+// @failure 500 {object} utils.Response
+// @router /websub/youtube [post]
+func (api *API) handleYoutubeWebSubNotification(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		utils.ResponseWithJSON(w, http.StatusBadRequest, nil, stack.Wrap(r.Context(), err, errors.ErrInvalidRequestFormat))
+		return
+	}
+
+	code, err := api.service.HandleYoutubeWebSub(r.Context(), body, r.Header.Get("X-Hub-Signature"))
+	utils.ResponseWithJSON(w, code, nil, stack.Wrap(r.Context(), err))
+}
@@ -5,14 +5,19 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/rl404/shimakaze/internal/utils"
+	"github.com/rl404/shimakaze/pkg/breaker"
 )
 
 // Ping contains basic routes.
-type Ping struct{}
+type Ping struct {
+	breakers []*breaker.Breaker
+}
 
 // New to create new ping and other base routes.
-func New() *Ping {
-	return &Ping{}
+func New(breakers ...*breaker.Breaker) *Ping {
+	return &Ping{
+		breakers: breakers,
+	}
 }
 
 // Register to register common routes.
@@ -30,8 +35,21 @@ func (p Ping) handleRoot(w http.ResponseWriter, _ *http.Request) {
 	utils.ResponseWithJSON(w, http.StatusOK, "ok", nil)
 }
 
-func (p Ping) handlePing(w http.ResponseWriter, _ *http.Request) {
-	utils.ResponseWithJSON(w, http.StatusOK, "pong", nil)
+type pingResponse struct {
+	Status   string                             `json:"status"`
+	Breakers map[string][]breaker.EndpointState `json:"breakers"`
+}
+
+func (p Ping) handlePing(w http.ResponseWriter, r *http.Request) {
+	breakers := make(map[string][]breaker.EndpointState)
+	for _, b := range p.breakers {
+		breakers[b.Name()] = b.GetStates(r.Context())
+	}
+
+	utils.ResponseWithJSON(w, http.StatusOK, pingResponse{
+		Status:   "pong",
+		Breakers: breakers,
+	}, nil)
 }
 
 func (p Ping) handleNotFound(w http.ResponseWriter, _ *http.Request) {
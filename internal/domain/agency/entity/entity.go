@@ -4,12 +4,17 @@ import "time"
 
 // Agency is entity for agency.
 type Agency struct {
-	ID         int64
-	Name       string
-	Image      string
-	Member     int
-	Subscriber int
-	UpdatedAt  time.Time
+	ID                 int64
+	Name               string
+	Image              string
+	Member             int
+	Subscriber         int
+	YoutubeSubscriber  int
+	TwitchSubscriber   int
+	BilibiliSubscriber int
+	NiconicoSubscriber int
+	TotalSubscriber    int
+	UpdatedAt          time.Time
 }
 
 // GetAllRequest is entity for get all request.
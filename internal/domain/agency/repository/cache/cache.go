@@ -10,6 +10,7 @@ import (
 	"github.com/rl404/shimakaze/internal/domain/agency/repository"
 	"github.com/rl404/shimakaze/internal/errors"
 	"github.com/rl404/shimakaze/internal/utils"
+	_cache "github.com/rl404/shimakaze/pkg/cache"
 )
 
 // Cache contains functions for agency cache.
@@ -45,6 +46,44 @@ func (c *Cache) GetByID(ctx context.Context, id int64) (data *entity.Agency, cod
 	return data, code, nil
 }
 
+// GetByIDs to get data by ids. Data is cached per id
+// like GetByID, so only ids not in cache are fetched.
+func (c *Cache) GetByIDs(ctx context.Context, ids []int64) ([]entity.Agency, int, error) {
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = utils.GetKey("agency", id)
+	}
+
+	var res []entity.Agency
+	var missingIDs []int64
+	for i, data := range _cache.GetMulti[entity.Agency](ctx, c.cacher, keys) {
+		if data != nil {
+			res = append(res, *data)
+			continue
+		}
+		missingIDs = append(missingIDs, ids[i])
+	}
+
+	if len(missingIDs) == 0 {
+		return res, http.StatusOK, nil
+	}
+
+	agencys, code, err := c.repo.GetByIDs(ctx, missingIDs)
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	// Cache error is only logged since the data is already fetched.
+	for _, agency := range agencys {
+		if err := c.cacher.Set(ctx, utils.GetKey("agency", agency.ID), agency); err != nil {
+			utils.Error("failed to cache agency %d: %s", agency.ID, err.Error())
+		}
+		res = append(res, agency)
+	}
+
+	return res, http.StatusOK, nil
+}
+
 // GetAllIDs to get all ids.
 func (c *Cache) GetAllIDs(ctx context.Context) (data []int64, code int, err error) {
 	return c.repo.GetAllIDs(ctx)
@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rl404/fairy/cache"
+	"github.com/rl404/fairy/cache/inmemory"
+	"github.com/rl404/shimakaze/internal/domain/agency/entity"
+	"github.com/rl404/shimakaze/internal/domain/agency/repository"
+)
+
+type stubRepository struct {
+	repository.Repository
+	fetchedIDs []int64
+}
+
+func (r *stubRepository) GetByIDs(ctx context.Context, ids []int64) ([]entity.Agency, int, error) {
+	r.fetchedIDs = append(r.fetchedIDs, ids...)
+	res := make([]entity.Agency, len(ids))
+	for i, id := range ids {
+		res[i] = entity.Agency{ID: id}
+	}
+	return res, http.StatusOK, nil
+}
+
+type failingSetCacher struct {
+	cache.Cacher
+}
+
+func (f *failingSetCacher) Set(ctx context.Context, key string, data interface{}, ttl ...time.Duration) error {
+	return errors.New("cache down")
+}
+
+func TestGetByIDs(t *testing.T) {
+	ctx := context.Background()
+
+	c, err := inmemory.New(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := &stubRepository{}
+	agencyCache := New(c, repo)
+
+	if _, _, err := agencyCache.GetByIDs(ctx, []int64{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	repo.fetchedIDs = nil
+	res, _, err := agencyCache.GetByIDs(ctx, []int64{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res) != 3 {
+		t.Fatalf("GetByIDs() len = %d, want 3", len(res))
+	}
+
+	if len(repo.fetchedIDs) != 1 || repo.fetchedIDs[0] != 3 {
+		t.Fatalf("GetByIDs() fetched %v, want [3]", repo.fetchedIDs)
+	}
+}
+
+func TestGetByIDsFailedSet(t *testing.T) {
+	c, err := inmemory.New(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	agencyCache := New(&failingSetCacher{Cacher: c}, &stubRepository{})
+
+	res, code, err := agencyCache.GetByIDs(context.Background(), []int64{1, 2})
+	if err != nil {
+		t.Fatalf("GetByIDs() error = %v, want nil", err)
+	}
+
+	if code != http.StatusOK || len(res) != 2 {
+		t.Fatalf("GetByIDs() = %v, %d, want 2 agencies", res, code)
+	}
+}
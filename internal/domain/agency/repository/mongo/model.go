@@ -8,13 +8,18 @@ import (
 )
 
 type agency struct {
-	ID         int64     `bson:"id"`
-	Name       string    `bson:"name"`
-	Image      string    `bson:"image"`
-	Member     int       `bson:"member"`
-	Subscriber int       `bson:"subscriber"`
-	CreatedAt  time.Time `bson:"created_at"`
-	UpdatedAt  time.Time `bson:"updated_at"`
+	ID                 int64     `bson:"id"`
+	Name               string    `bson:"name"`
+	Image              string    `bson:"image"`
+	Member             int       `bson:"member"`
+	Subscriber         int       `bson:"subscriber"`
+	YoutubeSubscriber  int       `bson:"youtube_subscriber"`
+	TwitchSubscriber   int       `bson:"twitch_subscriber"`
+	BilibiliSubscriber int       `bson:"bilibili_subscriber"`
+	NiconicoSubscriber int       `bson:"niconico_subscriber"`
+	TotalSubscriber    int       `bson:"total_subscriber"`
+	CreatedAt          time.Time `bson:"created_at"`
+	UpdatedAt          time.Time `bson:"updated_at"`
 }
 
 // MarshalBSON to override marshal function.
@@ -31,23 +36,33 @@ func (a *agency) MarshalBSON() ([]byte, error) {
 
 func (a *agency) toEntity() *entity.Agency {
 	return &entity.Agency{
-		ID:         a.ID,
-		Name:       a.Name,
-		Image:      a.Image,
-		Member:     a.Member,
-		Subscriber: a.Subscriber,
-		UpdatedAt:  a.UpdatedAt,
+		ID:                 a.ID,
+		Name:               a.Name,
+		Image:              a.Image,
+		Member:             a.Member,
+		Subscriber:         a.Subscriber,
+		YoutubeSubscriber:  a.YoutubeSubscriber,
+		TwitchSubscriber:   a.TwitchSubscriber,
+		BilibiliSubscriber: a.BilibiliSubscriber,
+		NiconicoSubscriber: a.NiconicoSubscriber,
+		TotalSubscriber:    a.TotalSubscriber,
+		UpdatedAt:          a.UpdatedAt,
 	}
 }
 
 func (m *Mongo) agencyFromEntity(a entity.Agency) *agency {
 	return &agency{
-		ID:         a.ID,
-		Name:       a.Name,
-		Image:      a.Image,
-		Member:     a.Member,
-		Subscriber: a.Subscriber,
-		UpdatedAt:  a.UpdatedAt,
+		ID:                 a.ID,
+		Name:               a.Name,
+		Image:              a.Image,
+		Member:             a.Member,
+		Subscriber:         a.Subscriber,
+		YoutubeSubscriber:  a.YoutubeSubscriber,
+		TwitchSubscriber:   a.TwitchSubscriber,
+		BilibiliSubscriber: a.BilibiliSubscriber,
+		NiconicoSubscriber: a.NiconicoSubscriber,
+		TotalSubscriber:    a.TotalSubscriber,
+		UpdatedAt:          a.UpdatedAt,
 	}
 }
 
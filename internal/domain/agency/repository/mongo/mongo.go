@@ -40,6 +40,26 @@ func (m *Mongo) GetByID(ctx context.Context, id int64) (*entity.Agency, int, err
 	return agency.toEntity(), http.StatusOK, nil
 }
 
+// GetByIDs to get data by ids.
+func (m *Mongo) GetByIDs(ctx context.Context, ids []int64) ([]entity.Agency, int, error) {
+	cursor, err := m.db.Find(ctx, bson.M{"id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	var agencys []agency
+	if err := cursor.All(ctx, &agencys); err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	res := make([]entity.Agency, len(agencys))
+	for i, agency := range agencys {
+		res[i] = *agency.toEntity()
+	}
+
+	return res, http.StatusOK, nil
+}
+
 // GetAllIDs to get all ids.
 func (m *Mongo) GetAllIDs(ctx context.Context) ([]int64, int, error) {
 	var ids []int64
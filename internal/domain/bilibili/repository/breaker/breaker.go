@@ -0,0 +1,60 @@
+package breaker
+
+import (
+	"context"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/bilibili/entity"
+	"github.com/rl404/shimakaze/internal/domain/bilibili/repository"
+	_breaker "github.com/rl404/shimakaze/pkg/breaker"
+)
+
+// Breaker contains functions for bilibili circuit breaker.
+type Breaker struct {
+	breaker *_breaker.Breaker
+	repo    repository.Repository
+}
+
+// New to create new bilibili circuit breaker.
+func New(breaker *_breaker.Breaker, repo repository.Repository) *Breaker {
+	return &Breaker{
+		breaker: breaker,
+		repo:    repo,
+	}
+}
+
+// GetUser to get user.
+func (b *Breaker) GetUser(ctx context.Context, id string) (data *entity.User, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetUser", func() (int, error) {
+		data, code, err = b.repo.GetUser(ctx, id)
+		return code, err
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
+
+// GetFollowerCount to get follower count.
+func (b *Breaker) GetFollowerCount(ctx context.Context, id string) (data int, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetFollowerCount", func() (int, error) {
+		data, code, err = b.repo.GetFollowerCount(ctx, id)
+		return code, err
+	})
+	if err != nil {
+		return 0, code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
+
+// GetVideos to get videos.
+func (b *Breaker) GetVideos(ctx context.Context, id string) (data []entity.Video, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetVideos", func() (int, error) {
+		data, code, err = b.repo.GetVideos(ctx, id)
+		return code, err
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
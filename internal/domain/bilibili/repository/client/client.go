@@ -5,24 +5,28 @@ import (
 	"time"
 
 	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/rl404/fairy/limit"
+	"github.com/rl404/fairy/limit/mutex"
 )
 
 // Client is bilibili api client.
 type Client struct {
-	host   string
-	http   *http.Client
-	maxAge time.Time
+	host    string
+	http    *http.Client
+	maxAge  time.Time
+	limiter limit.Limiter
 }
 
 // New to create new bilibili api client.
-func New(maxAge int) *Client {
+func New(maxAge, rateLimit int) *Client {
 	return &Client{
 		host: "https://api.bilibili.com",
 		http: &http.Client{
 			Timeout:   10 * time.Second,
 			Transport: newrelic.NewRoundTripper(&transportWithHeader{}),
 		},
-		maxAge: time.Now().Add(time.Duration(maxAge*-24) * time.Hour),
+		maxAge:  time.Now().Add(time.Duration(maxAge*-24) * time.Hour),
+		limiter: mutex.New(rateLimit, time.Second),
 	}
 }
 
@@ -30,6 +30,8 @@ func (c *Client) GetFollowerCount(ctx context.Context, id string) (int, int, err
 		return 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
 	}
 
+	c.limiter.Take()
+
 	resp, err := c.http.Do(req)
 	if err != nil {
 		return 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
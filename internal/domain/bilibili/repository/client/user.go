@@ -43,6 +43,8 @@ func (c *Client) GetUser(ctx context.Context, id string) (*entity.User, int, err
 		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
 	}
 
+	c.limiter.Take()
+
 	resp, err := c.http.Do(req)
 	if err != nil {
 		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
@@ -54,6 +54,8 @@ func (c *Client) GetVideos(ctx context.Context, id string) ([]entity.Video, int,
 			return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
 		}
 
+		c.limiter.Take()
+
 		resp, err := c.http.Do(req)
 		if err != nil {
 			return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
@@ -16,18 +16,61 @@ const (
 	Yearly  Group = "YEARLY"
 )
 
+// Aggregation is history aggregation.
+type Aggregation string
+
+// Available history aggregations.
+const (
+	AggregationMax  Aggregation = "MAX"
+	AggregationLast Aggregation = "LAST"
+	AggregationAvg  Aggregation = "AVG"
+	AggregationSum  Aggregation = "SUM"
+)
+
 // ChannelStats is entity for channel stats.
+//
+// View count and video count are only filled
+// if the platform provides them. Stream duration
+// is total stream duration (in seconds) since
+// the previous stats. Anomaly stats are excluded
+// from history and roll up.
 type ChannelStats struct {
-	VtuberID    int64
-	ChannelID   string
-	ChannelType vtuberEntity.ChannelType
-	Subscriber  int
-	CreatedAt   time.Time
+	VtuberID       int64
+	ChannelID      string
+	ChannelType    vtuberEntity.ChannelType
+	Subscriber     int
+	ViewCount      int
+	VideoCount     int
+	StreamDuration int
+	IsAnomaly      bool
+	CreatedAt      time.Time
 }
 
 // GetRequest is get request model.
 type GetRequest struct {
-	VtuberID  int64
-	StartDate time.Time
-	EndDate   time.Time
+	VtuberID    int64
+	StartDate   time.Time
+	EndDate     time.Time
+	Group       Group
+	Aggregation Aggregation
+}
+
+// GetAnomaliesRequest is get anomalies request model.
+type GetAnomaliesRequest struct {
+	VtuberID    int64
+	ChannelType vtuberEntity.ChannelType
+	Page        int
+	Limit       int
+}
+
+// GetRecentRequest is get recent request model.
+type GetRecentRequest struct {
+	VtuberID int64
+	Limit    int
+}
+
+// GetLatestRequest is get latest request model.
+type GetLatestRequest struct {
+	VtuberIDs []int64
+	AsOf      time.Time
 }
@@ -3,6 +3,7 @@ package cache
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/rl404/fairy/cache"
 	"github.com/rl404/fairy/errors/stack"
@@ -33,7 +34,7 @@ func (c *Cache) Create(ctx context.Context, data entity.ChannelStats) (int, erro
 
 // Get to get channel stats.
 func (c *Cache) Get(ctx context.Context, req entity.GetRequest) (data []entity.ChannelStats, code int, err error) {
-	key := utils.GetKey("channel-stats-history", req.VtuberID, req.StartDate, req.EndDate)
+	key := utils.GetKey("channel-stats-history", req.VtuberID, req.StartDate, req.EndDate, req.Group, req.Aggregation)
 	if c.cacher.Get(ctx, key, &data) == nil {
 		return data, http.StatusOK, nil
 	}
@@ -49,3 +50,28 @@ func (c *Cache) Get(ctx context.Context, req entity.GetRequest) (data []entity.C
 
 	return data, code, nil
 }
+
+// GetAnomalies to get anomaly channel stats.
+func (c *Cache) GetAnomalies(ctx context.Context, req entity.GetAnomaliesRequest) ([]entity.ChannelStats, int, int, error) {
+	return c.repo.GetAnomalies(ctx, req)
+}
+
+// GetLatest to get latest channel stats before the time.
+func (c *Cache) GetLatest(ctx context.Context, req entity.GetLatestRequest) ([]entity.ChannelStats, int, error) {
+	return c.repo.GetLatest(ctx, req)
+}
+
+// GetRecent to get recent raw channel stats.
+func (c *Cache) GetRecent(ctx context.Context, req entity.GetRecentRequest) ([]entity.ChannelStats, int, error) {
+	return c.repo.GetRecent(ctx, req)
+}
+
+// RollupDaily to roll up raw channel stats to daily stats.
+func (c *Cache) RollupDaily(ctx context.Context, before time.Time) (int, int, error) {
+	return c.repo.RollupDaily(ctx, before)
+}
+
+// RollupMonthly to roll up daily channel stats to monthly stats.
+func (c *Cache) RollupMonthly(ctx context.Context, before time.Time) (int, int, error) {
+	return c.repo.RollupMonthly(ctx, before)
+}
@@ -0,0 +1,106 @@
+package mongo
+
+import (
+	"context"
+	_errors "errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Anomaly stats are not rolled up, so they
+// are deleted after being kept for review.
+const anomalyTTL = 365 * 24 * time.Hour
+
+// Stats are rolled up in a window before their rollup
+// age and deleted after 2 windows, so rolled up stats
+// are never half-deleted and a missed rollup run can
+// still be caught up by the next run.
+const (
+	rawRollupWindow   = 3  // days
+	dailyRollupWindow = 1  // months
+	daysInMonth       = 31 // for ttl
+)
+
+// Existing index with different options.
+var indexConflictCodes = []int{85, 86}
+
+// CreateIndexes to create channel-stats-history collection indexes.
+//
+// Raw stats are deleted after raw age (in days) and daily
+// stats are deleted after daily age (in months) once they
+// are rolled up. Monthly stats are kept.
+func CreateIndexes(ctx context.Context, db *mongo.Database, rawAge, dailyAge int) error {
+	statsIndex := mongo.IndexModel{Keys: bson.D{{Key: "vtuber_id", Value: 1}, {Key: "created_at", Value: 1}}}
+
+	raw := db.Collection("channel_stats_history")
+
+	// Stats created before anomaly detection have
+	// no flag which is needed by the ttl index.
+	if _, err := raw.UpdateMany(ctx, bson.M{"is_anomaly": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"is_anomaly": false}}); err != nil {
+		return err
+	}
+
+	if _, err := raw.Indexes().CreateOne(ctx, statsIndex); err != nil {
+		return err
+	}
+
+	if err := createTTLIndex(ctx, raw, "created_at_raw_ttl", time.Duration(rawAge+2*rawRollupWindow)*24*time.Hour, bson.M{"is_anomaly": false}); err != nil {
+		return err
+	}
+
+	if err := createTTLIndex(ctx, raw, "created_at_anomaly_ttl", anomalyTTL, bson.M{"is_anomaly": true}); err != nil {
+		return err
+	}
+
+	daily := db.Collection("channel_stats_history_daily")
+
+	if _, err := daily.Indexes().CreateOne(ctx, statsIndex); err != nil {
+		return err
+	}
+
+	if err := createTTLIndex(ctx, daily, "created_at_1", time.Duration((dailyAge+2*dailyRollupWindow)*daysInMonth)*24*time.Hour, nil); err != nil {
+		return err
+	}
+
+	if _, err := db.Collection("channel_stats_history_monthly").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		statsIndex,
+		{Keys: bson.D{{Key: "created_at", Value: 1}}},
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// createTTLIndex to create ttl index on created date.
+// Existing index ttl is updated if the age is changed.
+func createTTLIndex(ctx context.Context, coll *mongo.Collection, name string, ttl time.Duration, filter bson.M) error {
+	opt := options.Index().SetName(name).SetExpireAfterSeconds(int32(ttl.Seconds()))
+	if filter != nil {
+		opt.SetPartialFilterExpression(filter)
+	}
+
+	_, err := coll.Indexes().CreateOne(ctx, mongo.IndexModel{Keys: bson.D{{Key: "created_at", Value: 1}}, Options: opt})
+	if err == nil {
+		return nil
+	}
+
+	var serverErr mongo.ServerError
+	if !_errors.As(err, &serverErr) {
+		return err
+	}
+
+	for _, code := range indexConflictCodes {
+		if serverErr.HasErrorCode(code) {
+			return coll.Database().RunCommand(ctx, bson.D{
+				{Key: "collMod", Value: coll.Name()},
+				{Key: "index", Value: bson.M{"name": name, "expireAfterSeconds": int32(ttl.Seconds())}},
+			}).Err()
+		}
+	}
+
+	return err
+}
@@ -9,11 +9,15 @@ import (
 )
 
 type channelStats struct {
-	VtuberID    int64                    `bson:"vtuber_id"`
-	ChannelID   string                   `bson:"channel_id"`
-	ChannelType vtuberEntity.ChannelType `bson:"channel_type"`
-	Subscriber  int                      `bson:"subscriber"`
-	CreatedAt   time.Time                `bson:"created_at"`
+	VtuberID       int64                    `bson:"vtuber_id"`
+	ChannelID      string                   `bson:"channel_id"`
+	ChannelType    vtuberEntity.ChannelType `bson:"channel_type"`
+	Subscriber     int                      `bson:"subscriber"`
+	ViewCount      int                      `bson:"view_count"`
+	VideoCount     int                      `bson:"video_count"`
+	StreamDuration int                      `bson:"stream_duration"`
+	IsAnomaly      bool                     `bson:"is_anomaly"`
+	CreatedAt      time.Time                `bson:"created_at"`
 }
 
 // MarshalBSON to override marshal function.
@@ -28,10 +32,106 @@ func (cs *channelStats) MarshalBSON() ([]byte, error) {
 
 func (cs *channelStats) toEntity() entity.ChannelStats {
 	return entity.ChannelStats{
-		VtuberID:    cs.VtuberID,
-		ChannelID:   cs.ChannelID,
-		ChannelType: cs.ChannelType,
-		Subscriber:  cs.Subscriber,
-		CreatedAt:   cs.CreatedAt,
+		VtuberID:       cs.VtuberID,
+		ChannelID:      cs.ChannelID,
+		ChannelType:    cs.ChannelType,
+		Subscriber:     cs.Subscriber,
+		ViewCount:      cs.ViewCount,
+		VideoCount:     cs.VideoCount,
+		StreamDuration: cs.StreamDuration,
+		IsAnomaly:      cs.IsAnomaly,
+		CreatedAt:      cs.CreatedAt,
+	}
+}
+
+type channelStatsMetric struct {
+	Subscriber     int `bson:"subscriber"`
+	ViewCount      int `bson:"view_count"`
+	VideoCount     int `bson:"video_count"`
+	StreamDuration int `bson:"stream_duration"`
+}
+
+// channelStatsRollup is channel stats summary in a period.
+// Created date is the start of the period.
+type channelStatsRollup struct {
+	VtuberID    int64                    `bson:"vtuber_id"`
+	ChannelID   string                   `bson:"channel_id"`
+	ChannelType vtuberEntity.ChannelType `bson:"channel_type"`
+	Count       int                      `bson:"count"`
+	Max         channelStatsMetric       `bson:"max"`
+	Sum         channelStatsMetric       `bson:"sum"`
+	Last        channelStatsMetric       `bson:"last"`
+	LastAt      time.Time                `bson:"last_at"`
+	CreatedAt   time.Time                `bson:"created_at"`
+}
+
+type channelStatsGroup struct {
+	ID struct {
+		VtuberID    int64                    `bson:"vtuber_id"`
+		ChannelID   string                   `bson:"channel_id"`
+		ChannelType vtuberEntity.ChannelType `bson:"channel_type"`
+		Date        time.Time                `bson:"date"`
+	} `bson:"_id"`
+	Count  int                `bson:"count"`
+	Max    channelStatsMetric `bson:"max"`
+	Sum    channelStatsMetric `bson:"sum"`
+	Last   channelStatsMetric `bson:"last"`
+	LastAt time.Time          `bson:"last_at"`
+}
+
+func (csg *channelStatsGroup) toRollup() *channelStatsRollup {
+	return &channelStatsRollup{
+		VtuberID:    csg.ID.VtuberID,
+		ChannelID:   csg.ID.ChannelID,
+		ChannelType: csg.ID.ChannelType,
+		Count:       csg.Count,
+		Max:         csg.Max,
+		Sum:         csg.Sum,
+		Last:        csg.Last,
+		LastAt:      csg.LastAt,
+		CreatedAt:   csg.ID.Date,
+	}
+}
+
+func (csg *channelStatsGroup) toEntity(aggregation entity.Aggregation) entity.ChannelStats {
+	metric := csg.Max
+	switch aggregation {
+	case entity.AggregationLast:
+		metric = csg.Last
+	case entity.AggregationSum:
+		metric = csg.Sum
+	case entity.AggregationAvg:
+		if csg.Count > 0 {
+			metric = channelStatsMetric{
+				Subscriber:     csg.Sum.Subscriber / csg.Count,
+				ViewCount:      csg.Sum.ViewCount / csg.Count,
+				VideoCount:     csg.Sum.VideoCount / csg.Count,
+				StreamDuration: csg.Sum.StreamDuration / csg.Count,
+			}
+		}
+	}
+
+	return entity.ChannelStats{
+		VtuberID:       csg.ID.VtuberID,
+		ChannelID:      csg.ID.ChannelID,
+		ChannelType:    csg.ID.ChannelType,
+		Subscriber:     metric.Subscriber,
+		ViewCount:      metric.ViewCount,
+		VideoCount:     metric.VideoCount,
+		StreamDuration: metric.StreamDuration,
+		CreatedAt:      csg.ID.Date,
+	}
+}
+
+func (csr *channelStatsRollup) toEntity() entity.ChannelStats {
+	return entity.ChannelStats{
+		VtuberID:       csr.VtuberID,
+		ChannelID:      csr.ChannelID,
+		ChannelType:    csr.ChannelType,
+		Subscriber:     csr.Last.Subscriber,
+		ViewCount:      csr.Last.ViewCount,
+		VideoCount:     csr.Last.VideoCount,
+		StreamDuration: csr.Last.StreamDuration,
+		CreatedAt:      csr.LastAt,
 	}
 }
@@ -2,7 +2,9 @@ package mongo
 
 import (
 	"context"
+	_errors "errors"
 	"net/http"
+	"time"
 
 	"github.com/rl404/fairy/errors/stack"
 	"github.com/rl404/shimakaze/internal/domain/channel_stats_history/entity"
@@ -13,24 +15,37 @@ import (
 )
 
 // Mongo contains functions for channel-stats-history mongodb.
+//
+// Raw channel stats are rolled up to daily stats and
+// daily stats are rolled up to monthly stats when
+// they are old. Rolled up stats are deleted by ttl
+// index so the collections will not grow without limit.
 type Mongo struct {
-	db *mongo.Collection
+	db      *mongo.Collection
+	daily   *mongo.Collection
+	monthly *mongo.Collection
 }
 
 // New to create new channel-stats-history mongodb.
 func New(db *mongo.Database) *Mongo {
 	return &Mongo{
-		db: db.Collection("channel_stats_history"),
+		db:      db.Collection("channel_stats_history"),
+		daily:   db.Collection("channel_stats_history_daily"),
+		monthly: db.Collection("channel_stats_history_monthly"),
 	}
 }
 
 // Create to create channel stats.
 func (m *Mongo) Create(ctx context.Context, data entity.ChannelStats) (int, error) {
 	if _, err := m.db.InsertOne(ctx, &channelStats{
-		VtuberID:    data.VtuberID,
-		ChannelID:   data.ChannelID,
-		ChannelType: data.ChannelType,
-		Subscriber:  data.Subscriber,
+		VtuberID:       data.VtuberID,
+		ChannelID:      data.ChannelID,
+		ChannelType:    data.ChannelType,
+		Subscriber:     data.Subscriber,
+		ViewCount:      data.ViewCount,
+		VideoCount:     data.VideoCount,
+		StreamDuration: data.StreamDuration,
+		IsAnomaly:      data.IsAnomaly,
 	}); err != nil {
 		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
 	}
@@ -38,18 +53,233 @@ func (m *Mongo) Create(ctx context.Context, data entity.ChannelStats) (int, erro
 }
 
 // Get to get channel stats.
+// Only collections which may have stats in the date
+// range are combined and grouped by the request group.
 func (m *Mongo) Get(ctx context.Context, data entity.GetRequest) ([]entity.ChannelStats, int, error) {
-	match := bson.M{
-		"vtuber_id": data.VtuberID,
-		"$and": bson.A{
-			bson.M{"created_at": bson.M{"$gte": bson.NewDateTimeFromTime(data.StartDate)}},
-			bson.M{"created_at": bson.M{"$lte": bson.NewDateTimeFromTime(data.EndDate)}},
+	// Align to the period start so the rolled up
+	// stats of the first period are included.
+	startDate := m.getPeriodStart(data.StartDate, data.Group)
+
+	sources, code, err := m.getSources(ctx, data.VtuberID, startDate, data.EndDate)
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	if len(sources) == 0 {
+		return nil, http.StatusOK, nil
+	}
+
+	pipeline := mongo.Pipeline(sources[0].stages)
+	for _, source := range sources[1:] {
+		pipeline = append(pipeline, bson.D{{Key: "$unionWith", Value: bson.M{
+			"coll":     source.coll.Name(),
+			"pipeline": source.stages,
+		}}})
+	}
+
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.M{"last_at": 1}}},
+		m.getGroupStage(m.getDateExpr(data.Group)),
+		m.getGroupProjectStage(),
+		bson.D{{Key: "$sort", Value: bson.M{"_id.date": 1}}},
+	)
+
+	c, err := sources[0].coll.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+	defer c.Close(ctx)
+
+	var histories []entity.ChannelStats
+	for c.Next(ctx) {
+		var group channelStatsGroup
+		if err := c.Decode(&group); err != nil {
+			return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		}
+		histories = append(histories, group.toEntity(data.Aggregation))
+	}
+
+	return histories, http.StatusOK, nil
+}
+
+type statsSource struct {
+	coll   *mongo.Collection
+	stages mongo.Pipeline
+}
+
+// getSources to get collections which may have stats in the
+// date range. Raw and daily stats are kept for a while after
+// rolled up, so raw stats are only used after the newest daily
+// stats and daily stats are only used after the newest monthly
+// stats to not count the same period twice.
+func (m *Mongo) getSources(ctx context.Context, vtuberID int64, startDate, endDate time.Time) ([]statsSource, int, error) {
+	dailyNewest, code, err := m.getNewestDate(ctx, m.daily, vtuberID)
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	monthlyNewest, code, err := m.getNewestDate(ctx, m.monthly, vtuberID)
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	return m.getSourcesBetween(vtuberID, startDate, endDate, dailyNewest, monthlyNewest), http.StatusOK, nil
+}
+
+// getSourcesBetween to get collections which may have stats
+// in the date range using the newest daily and monthly date.
+func (m *Mongo) getSourcesBetween(vtuberID int64, startDate, endDate time.Time, dailyNewest, monthlyNewest *time.Time) []statsSource {
+	matchStage := func(start time.Time, end bson.M) bson.D {
+		end["$gte"] = bson.NewDateTimeFromTime(start)
+		return bson.D{{Key: "$match", Value: bson.M{
+			"vtuber_id":  vtuberID,
+			"is_anomaly": bson.M{"$ne": true},
+			"created_at": end,
+		}}}
+	}
+
+	var sources []statsSource
+
+	rawStart := startDate
+	if dailyNewest != nil && rawStart.Before(dailyNewest.AddDate(0, 0, 1)) {
+		rawStart = dailyNewest.AddDate(0, 0, 1)
+	}
+
+	if !endDate.Before(rawStart) {
+		sources = append(sources, statsSource{
+			coll:   m.db,
+			stages: mongo.Pipeline{matchStage(rawStart, bson.M{"$lte": bson.NewDateTimeFromTime(endDate)}), m.getRawToRollupStage()},
+		})
+	}
+
+	dailyStart := startDate
+	if monthlyNewest != nil && dailyStart.Before(monthlyNewest.AddDate(0, 1, 0)) {
+		dailyStart = monthlyNewest.AddDate(0, 1, 0)
+	}
+
+	if dailyNewest != nil && !dailyNewest.Before(dailyStart) && !endDate.Before(dailyStart) {
+		sources = append(sources, statsSource{
+			coll: m.daily,
+			stages: mongo.Pipeline{matchStage(dailyStart, bson.M{
+				"$lte": bson.NewDateTimeFromTime(endDate),
+				"$lt":  bson.NewDateTimeFromTime(rawStart),
+			})},
+		})
+	}
+
+	monthlyStart := m.getPeriodStart(startDate, entity.Monthly)
+	if monthlyNewest != nil && !monthlyNewest.Before(monthlyStart) {
+		sources = append(sources, statsSource{
+			coll: m.monthly,
+			stages: mongo.Pipeline{matchStage(monthlyStart, bson.M{
+				"$lte": bson.NewDateTimeFromTime(endDate),
+				"$lt":  bson.NewDateTimeFromTime(dailyStart),
+			})},
+		})
+	}
+
+	return sources
+}
+
+// getNewestDate to get the newest stats date of the vtuber
+// in the collection. Nil if there is no stats.
+func (m *Mongo) getNewestDate(ctx context.Context, coll *mongo.Collection, vtuberID int64) (*time.Time, int, error) {
+	var stats struct {
+		CreatedAt time.Time `bson:"created_at"`
+	}
+
+	if err := coll.FindOne(ctx, bson.M{"vtuber_id": vtuberID}, options.FindOne().
+		SetSort(bson.D{{Key: "vtuber_id", Value: -1}, {Key: "created_at", Value: -1}}).
+		SetProjection(bson.M{"created_at": 1})).Decode(&stats); err != nil {
+		if _errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, http.StatusOK, nil
+		}
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	createdAt := stats.CreatedAt.UTC()
+	return &createdAt, http.StatusOK, nil
+}
+
+// getPeriodStart to get the start of the group period.
+func (m *Mongo) getPeriodStart(t time.Time, group entity.Group) time.Time {
+	t = t.UTC()
+	switch group {
+	case entity.Daily:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	case entity.Monthly:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// GetLatest to get latest channel stats before the time.
+func (m *Mongo) GetLatest(ctx context.Context, data entity.GetLatestRequest) ([]entity.ChannelStats, int, error) {
+	matchStage := bson.D{{Key: "$match", Value: bson.M{
+		"vtuber_id":  bson.M{"$in": data.VtuberIDs},
+		"created_at": bson.M{"$lte": bson.NewDateTimeFromTime(data.AsOf)},
+		"is_anomaly": bson.M{"$ne": true},
+	}}}
+	rollupMatchStage := bson.D{{Key: "$match", Value: bson.M{
+		"vtuber_id": bson.M{"$in": data.VtuberIDs},
+		"last_at":   bson.M{"$lte": bson.NewDateTimeFromTime(data.AsOf)},
+	}}}
+	sortStage := bson.D{{Key: "$sort", Value: bson.M{"last_at": -1}}}
+	groupStage := bson.D{{Key: "$group", Value: bson.M{
+		"_id": bson.M{
+			"vtuber_id":    "$vtuber_id",
+			"channel_id":   "$channel_id",
+			"channel_type": "$channel_type",
 		},
+		"history": bson.M{"$first": "$$ROOT"},
+	}}}
+	replaceStage := bson.D{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$history"}}}
+
+	pipeline := mongo.Pipeline{
+		matchStage,
+		m.getRawToRollupStage(),
+		m.getUnionStage(m.daily, rollupMatchStage),
+		m.getUnionStage(m.monthly, rollupMatchStage),
+		sortStage,
+		groupStage,
+		replaceStage,
 	}
 
-	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(0)
+	c, err := m.db.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+	defer c.Close(ctx)
+
+	var histories []entity.ChannelStats
+	for c.Next(ctx) {
+		var history channelStatsRollup
+		if err := c.Decode(&history); err != nil {
+			return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		}
+		histories = append(histories, history.toEntity())
+	}
 
-	c, err := m.db.Find(ctx, match, opts)
+	return histories, http.StatusOK, nil
+}
+
+// GetRecent to get latest raw channel stats of each
+// channel including the anomaly ones. Newest first.
+func (m *Mongo) GetRecent(ctx context.Context, data entity.GetRecentRequest) ([]entity.ChannelStats, int, error) {
+	matchStage := bson.D{{Key: "$match", Value: bson.M{"vtuber_id": data.VtuberID}}}
+	sortStage := bson.D{{Key: "$sort", Value: bson.M{"created_at": -1}}}
+	groupStage := bson.D{{Key: "$group", Value: bson.M{
+		"_id": bson.M{
+			"channel_id":   "$channel_id",
+			"channel_type": "$channel_type",
+		},
+		"stats": bson.M{"$firstN": bson.M{"input": "$$ROOT", "n": data.Limit}},
+	}}}
+	unwindStage := bson.D{{Key: "$unwind", Value: "$stats"}}
+	replaceStage := bson.D{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$stats"}}}
+
+	c, err := m.db.Aggregate(ctx, mongo.Pipeline{matchStage, sortStage, groupStage, unwindStage, replaceStage, sortStage})
 	if err != nil {
 		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
 	}
@@ -66,3 +296,195 @@ func (m *Mongo) Get(ctx context.Context, data entity.GetRequest) ([]entity.Chann
 
 	return histories, http.StatusOK, nil
 }
+
+// GetAnomalies to get anomaly channel stats.
+func (m *Mongo) GetAnomalies(ctx context.Context, data entity.GetAnomaliesRequest) ([]entity.ChannelStats, int, int, error) {
+	query := bson.M{"is_anomaly": true}
+	opt := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).SetSkip(int64((data.Page - 1) * data.Limit)).SetLimit(int64(data.Limit))
+
+	if data.VtuberID > 0 {
+		query["vtuber_id"] = data.VtuberID
+	}
+
+	if data.ChannelType != "" {
+		query["channel_type"] = data.ChannelType
+	}
+
+	if data.Limit < 0 {
+		opt.SetLimit(0)
+	}
+
+	c, err := m.db.Find(ctx, query, opt)
+	if err != nil {
+		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+	defer c.Close(ctx)
+
+	var histories []entity.ChannelStats
+	for c.Next(ctx) {
+		var history channelStats
+		if err := c.Decode(&history); err != nil {
+			return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		}
+		histories = append(histories, history.toEntity())
+	}
+
+	total, err := m.db.CountDocuments(ctx, query, options.Count())
+	if err != nil {
+		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	return histories, int(total), http.StatusOK, nil
+}
+
+// RollupDaily to roll up raw channel stats in the rollup
+// window before the time to daily stats. Rolled up raw
+// stats will be deleted by ttl index. Anomaly stats are
+// kept for review.
+func (m *Mongo) RollupDaily(ctx context.Context, before time.Time) (int, int, error) {
+	return m.rollup(ctx, m.db, m.daily, entity.Daily, bson.M{
+		"created_at": bson.M{
+			"$gte": bson.NewDateTimeFromTime(before.AddDate(0, 0, -rawRollupWindow)),
+			"$lt":  bson.NewDateTimeFromTime(before),
+		},
+		"is_anomaly": false,
+	}, m.getRawToRollupStage())
+}
+
+// RollupMonthly to roll up daily channel stats in the rollup
+// window before the time to monthly stats. Rolled up daily
+// stats will be deleted by ttl index.
+func (m *Mongo) RollupMonthly(ctx context.Context, before time.Time) (int, int, error) {
+	return m.rollup(ctx, m.daily, m.monthly, entity.Monthly, bson.M{
+		"created_at": bson.M{
+			"$gte": bson.NewDateTimeFromTime(before.AddDate(0, -dailyRollupWindow, 0)),
+			"$lt":  bson.NewDateTimeFromTime(before),
+		},
+	}, bson.D{})
+}
+
+func (m *Mongo) rollup(ctx context.Context, source, target *mongo.Collection, group entity.Group, filter bson.M, rollupStage bson.D) (int, int, error) {
+	matchStage := bson.D{{Key: "$match", Value: filter}}
+	sortStage := bson.D{{Key: "$sort", Value: bson.M{"last_at": 1}}}
+
+	pipeline := mongo.Pipeline{matchStage}
+	if len(rollupStage) > 0 {
+		pipeline = append(pipeline, rollupStage)
+	}
+	pipeline = append(pipeline, sortStage, m.getGroupStage(m.getDateExpr(group)), m.getGroupProjectStage())
+
+	c, err := source.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+	defer c.Close(ctx)
+
+	var cnt int
+	for c.Next(ctx) {
+		var g channelStatsGroup
+		if err := c.Decode(&g); err != nil {
+			return cnt, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		}
+
+		// Replace instead of increment because the
+		// same period is rolled up again in next run.
+		if _, err := target.ReplaceOne(ctx, bson.M{
+			"vtuber_id":    g.ID.VtuberID,
+			"channel_id":   g.ID.ChannelID,
+			"channel_type": g.ID.ChannelType,
+			"created_at":   g.ID.Date,
+		}, g.toRollup(), options.Replace().SetUpsert(true)); err != nil {
+			return cnt, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		}
+
+		cnt += g.Count
+	}
+
+	return cnt, http.StatusOK, nil
+}
+
+// getRawToRollupStage to convert raw stats to the
+// same shape as rolled up stats.
+func (m *Mongo) getRawToRollupStage() bson.D {
+	metric := bson.M{}
+	for _, f := range m.getMetricFields() {
+		metric[f] = "$" + f
+	}
+
+	return bson.D{{Key: "$project", Value: bson.M{
+		"vtuber_id":    1,
+		"channel_id":   1,
+		"channel_type": 1,
+		"created_at":   1,
+		"count":        bson.M{"$literal": 1},
+		"max":          metric,
+		"sum":          metric,
+		"last":         metric,
+		"last_at":      "$created_at",
+	}}}
+}
+
+func (m *Mongo) getUnionStage(coll *mongo.Collection, matchStage bson.D) bson.D {
+	return bson.D{{Key: "$unionWith", Value: bson.M{
+		"coll":     coll.Name(),
+		"pipeline": mongo.Pipeline{matchStage},
+	}}}
+}
+
+// getGroupStage to group rolled up stats by channel and date.
+// Stats should be sorted by last date ascending.
+func (m *Mongo) getGroupStage(date bson.M) bson.D {
+	group := bson.M{
+		"_id": bson.M{
+			"vtuber_id":    "$vtuber_id",
+			"channel_id":   "$channel_id",
+			"channel_type": "$channel_type",
+			"date":         date,
+		},
+		"count":   bson.M{"$sum": "$count"},
+		"last_at": bson.M{"$last": "$last_at"},
+	}
+
+	for _, f := range m.getMetricFields() {
+		group["max_"+f] = bson.M{"$max": "$max." + f}
+		group["sum_"+f] = bson.M{"$sum": "$sum." + f}
+		group["last_"+f] = bson.M{"$last": "$last." + f}
+	}
+
+	return bson.D{{Key: "$group", Value: group}}
+}
+
+func (m *Mongo) getGroupProjectStage() bson.D {
+	maxMetric, sumMetric, lastMetric := bson.M{}, bson.M{}, bson.M{}
+	for _, f := range m.getMetricFields() {
+		maxMetric[f] = "$max_" + f
+		sumMetric[f] = "$sum_" + f
+		lastMetric[f] = "$last_" + f
+	}
+
+	return bson.D{{Key: "$project", Value: bson.M{
+		"count":   1,
+		"last_at": 1,
+		"max":     maxMetric,
+		"sum":     sumMetric,
+		"last":    lastMetric,
+	}}}
+}
+
+func (m *Mongo) getMetricFields() []string {
+	return []string{"subscriber", "view_count", "video_count", "stream_duration"}
+}
+
+func (m *Mongo) getDateExpr(group entity.Group) bson.M {
+	parts := bson.M{"year": bson.M{"$year": "$created_at"}}
+
+	switch group {
+	case entity.Monthly:
+		parts["month"] = bson.M{"$month": "$created_at"}
+	case entity.Daily:
+		parts["month"] = bson.M{"$month": "$created_at"}
+		parts["day"] = bson.M{"$dayOfMonth": "$created_at"}
+	}
+
+	return bson.M{"$dateFromParts": parts}
+}
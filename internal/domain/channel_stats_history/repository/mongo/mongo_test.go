@@ -0,0 +1,139 @@
+package mongo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rl404/shimakaze/internal/domain/channel_stats_history/entity"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func newTestMongo(t *testing.T) *Mongo {
+	// Client does not connect until it is used.
+	client, err := mongo.Connect(options.Client().ApplyURI("mongodb://localhost:27017"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New(client.Database("test"))
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestGetSourcesBetween(t *testing.T) {
+	type source struct {
+		coll string
+		from time.Time
+		to   *time.Time
+	}
+
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	tests := []struct {
+		name          string
+		start         time.Time
+		end           time.Time
+		dailyNewest   *time.Time
+		monthlyNewest *time.Time
+		want          []source
+	}{
+		{
+			name:  "raw-only",
+			start: date(2024, 1, 1),
+			end:   date(2024, 4, 30),
+			want:  []source{{coll: "channel_stats_history", from: date(2024, 1, 1)}},
+		},
+		{
+			name:        "raw-after-newest-daily",
+			start:       date(2024, 1, 1),
+			end:         date(2024, 4, 30),
+			dailyNewest: ptr(date(2024, 3, 10)),
+			want: []source{
+				{coll: "channel_stats_history", from: date(2024, 3, 11)},
+				{coll: "channel_stats_history_daily", from: date(2024, 1, 1), to: ptr(date(2024, 3, 11))},
+			},
+		},
+		{
+			name:          "all-without-overlap",
+			start:         date(2023, 6, 15),
+			end:           date(2024, 4, 30),
+			dailyNewest:   ptr(date(2024, 3, 10)),
+			monthlyNewest: ptr(date(2024, 1, 1)),
+			want: []source{
+				{coll: "channel_stats_history", from: date(2024, 3, 11)},
+				{coll: "channel_stats_history_daily", from: date(2024, 2, 1), to: ptr(date(2024, 3, 11))},
+				{coll: "channel_stats_history_monthly", from: date(2023, 6, 1), to: ptr(date(2024, 2, 1))},
+			},
+		},
+		{
+			name:          "start-after-rollup",
+			start:         date(2024, 4, 1),
+			end:           date(2024, 4, 30),
+			dailyNewest:   ptr(date(2024, 3, 10)),
+			monthlyNewest: ptr(date(2024, 1, 1)),
+			want:          []source{{coll: "channel_stats_history", from: date(2024, 4, 1)}},
+		},
+		{
+			name:          "end-before-raw",
+			start:         date(2023, 6, 15),
+			end:           date(2023, 12, 31),
+			dailyNewest:   ptr(date(2024, 3, 10)),
+			monthlyNewest: ptr(date(2024, 1, 1)),
+			want: []source{
+				{coll: "channel_stats_history_monthly", from: date(2023, 6, 1), to: ptr(date(2024, 2, 1))},
+			},
+		},
+	}
+
+	m := newTestMongo(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sources := m.getSourcesBetween(1, tt.start, tt.end, tt.dailyNewest, tt.monthlyNewest)
+			if len(sources) != len(tt.want) {
+				t.Fatalf("len = %d, want %d", len(sources), len(tt.want))
+			}
+
+			for i, s := range sources {
+				createdAt := s.stages[0][0].Value.(bson.M)["created_at"].(bson.M)
+
+				if s.coll.Name() != tt.want[i].coll {
+					t.Errorf("source %d coll = %s, want %s", i, s.coll.Name(), tt.want[i].coll)
+				}
+
+				if from := createdAt["$gte"].(bson.DateTime).Time().UTC(); !from.Equal(tt.want[i].from) {
+					t.Errorf("source %d from = %v, want %v", i, from, tt.want[i].from)
+				}
+
+				to, ok := createdAt["$lt"].(bson.DateTime)
+				if ok != (tt.want[i].to != nil) || (ok && !to.Time().Equal(*tt.want[i].to)) {
+					t.Errorf("source %d to = %v, want %v", i, createdAt["$lt"], tt.want[i].to)
+				}
+			}
+		})
+	}
+}
+
+func TestGetPeriodStart(t *testing.T) {
+	tm := time.Date(2024, 3, 10, 15, 4, 5, 0, time.FixedZone("JST", 9*60*60))
+
+	tests := []struct {
+		group entity.Group
+		want  time.Time
+	}{
+		{group: entity.Daily, want: date(2024, 3, 10)},
+		{group: entity.Monthly, want: date(2024, 3, 1)},
+		{group: entity.Yearly, want: date(2024, 1, 1)},
+	}
+
+	m := newTestMongo(t)
+	for _, tt := range tests {
+		t.Run(string(tt.group), func(t *testing.T) {
+			if got := m.getPeriodStart(tm, tt.group); !got.Equal(tt.want) {
+				t.Fatalf("getPeriodStart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/rl404/shimakaze/internal/domain/channel_stats_history/entity"
 )
@@ -10,4 +11,9 @@ import (
 type Repository interface {
 	Create(ctx context.Context, data entity.ChannelStats) (int, error)
 	Get(ctx context.Context, data entity.GetRequest) ([]entity.ChannelStats, int, error)
+	GetAnomalies(ctx context.Context, data entity.GetAnomaliesRequest) ([]entity.ChannelStats, int, int, error)
+	GetLatest(ctx context.Context, data entity.GetLatestRequest) ([]entity.ChannelStats, int, error)
+	GetRecent(ctx context.Context, data entity.GetRecentRequest) ([]entity.ChannelStats, int, error)
+	RollupDaily(ctx context.Context, before time.Time) (int, int, error)
+	RollupMonthly(ctx context.Context, before time.Time) (int, int, error)
 }
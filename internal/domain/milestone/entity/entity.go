@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"time"
+
+	vtuberEntity "github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+)
+
+// Milestone is entity for channel subscriber milestone.
+type Milestone struct {
+	VtuberID    int64
+	VtuberName  string
+	VtuberImage string
+	ChannelID   string
+	ChannelName string
+	ChannelType vtuberEntity.ChannelType
+	Milestone   int
+	Subscriber  int
+	ReachedAt   time.Time
+}
+
+// CreateCrossedRequest is create crossed milestone request model.
+type CreateCrossedRequest struct {
+	VtuberID      int64
+	VtuberName    string
+	VtuberImage   string
+	ChannelID     string
+	ChannelName   string
+	ChannelType   vtuberEntity.ChannelType
+	OldSubscriber int
+	NewSubscriber int
+}
+
+// GetUnpublishedRequest is get unpublished request model.
+type GetUnpublishedRequest struct {
+	VtuberID    int64
+	ChannelID   string
+	ChannelType vtuberEntity.ChannelType
+}
+
+// GetAllRequest is get all request model.
+type GetAllRequest struct {
+	VtuberID     int64
+	ChannelType  vtuberEntity.ChannelType
+	MinMilestone int
+	StartDate    *time.Time
+	Page         int
+	Limit        int
+}
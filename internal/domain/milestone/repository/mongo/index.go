@@ -0,0 +1,28 @@
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// CreateIndexes to create milestone collection indexes.
+// Each channel milestone is unique so concurrent
+// upsert will not create duplicate.
+func CreateIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("milestones").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "vtuber_id", Value: 1},
+				{Key: "channel_id", Value: 1},
+				{Key: "channel_type", Value: 1},
+				{Key: "milestone", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "reached_at", Value: -1}, {Key: "milestone", Value: -1}}},
+	})
+	return err
+}
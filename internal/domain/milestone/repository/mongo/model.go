@@ -0,0 +1,35 @@
+package mongo
+
+import (
+	"time"
+
+	"github.com/rl404/shimakaze/internal/domain/milestone/entity"
+	vtuberEntity "github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+)
+
+type milestone struct {
+	VtuberID    int64                    `bson:"vtuber_id"`
+	VtuberName  string                   `bson:"vtuber_name"`
+	VtuberImage string                   `bson:"vtuber_image"`
+	ChannelID   string                   `bson:"channel_id"`
+	ChannelName string                   `bson:"channel_name"`
+	ChannelType vtuberEntity.ChannelType `bson:"channel_type"`
+	Milestone   int                      `bson:"milestone"`
+	Subscriber  int                      `bson:"subscriber"`
+	ReachedAt   time.Time                `bson:"reached_at"`
+	IsPublished *bool                    `bson:"is_published,omitempty"`
+}
+
+func (m *milestone) toEntity() entity.Milestone {
+	return entity.Milestone{
+		VtuberID:    m.VtuberID,
+		VtuberName:  m.VtuberName,
+		VtuberImage: m.VtuberImage,
+		ChannelID:   m.ChannelID,
+		ChannelName: m.ChannelName,
+		ChannelType: m.ChannelType,
+		Milestone:   m.Milestone,
+		Subscriber:  m.Subscriber,
+		ReachedAt:   m.ReachedAt,
+	}
+}
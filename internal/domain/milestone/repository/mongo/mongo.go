@@ -0,0 +1,173 @@
+package mongo
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/milestone/entity"
+	vtuberEntity "github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/errors"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Mongo contains functions for milestone mongodb.
+type Mongo struct {
+	db         *mongo.Collection
+	milestones []int
+}
+
+// New to create new milestone mongodb.
+func New(db *mongo.Database, milestones []int) *Mongo {
+	return &Mongo{
+		db:         db.Collection("milestones"),
+		milestones: milestones,
+	}
+}
+
+// CreateCrossed to create milestones crossed between
+// old and new subscriber. Each channel milestone is
+// only saved once so dropping below and crossing it
+// again will not create a new one. New milestones
+// are unpublished until marked as published.
+func (m *Mongo) CreateCrossed(ctx context.Context, data entity.CreateCrossedRequest) (int, error) {
+	if data.OldSubscriber <= 0 || data.NewSubscriber <= data.OldSubscriber {
+		return http.StatusOK, nil
+	}
+
+	now := time.Now()
+	isPublished := false
+
+	for _, ms := range m.milestones {
+		if data.OldSubscriber >= ms || data.NewSubscriber < ms {
+			continue
+		}
+
+		if _, err := m.db.UpdateOne(ctx, m.getKey(data.VtuberID, data.ChannelID, data.ChannelType, ms), bson.M{"$setOnInsert": milestone{
+			VtuberID:    data.VtuberID,
+			VtuberName:  data.VtuberName,
+			VtuberImage: data.VtuberImage,
+			ChannelID:   data.ChannelID,
+			ChannelName: data.ChannelName,
+			ChannelType: data.ChannelType,
+			Milestone:   ms,
+			Subscriber:  data.NewSubscriber,
+			ReachedAt:   now,
+			IsPublished: &isPublished,
+		}}, options.UpdateOne().SetUpsert(true)); err != nil {
+			return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		}
+	}
+
+	return http.StatusCreated, nil
+}
+
+// GetUnpublished to get unpublished channel milestones.
+// Milestones saved before the flag exists are
+// considered published.
+func (m *Mongo) GetUnpublished(ctx context.Context, data entity.GetUnpublishedRequest) ([]entity.Milestone, int, error) {
+	c, err := m.db.Find(ctx, bson.M{
+		"vtuber_id":    data.VtuberID,
+		"channel_id":   data.ChannelID,
+		"channel_type": data.ChannelType,
+		"is_published": false,
+	}, options.Find().SetSort(bson.D{{Key: "milestone", Value: 1}}))
+	if err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+	defer c.Close(ctx)
+
+	var milestones []entity.Milestone
+	for c.Next(ctx) {
+		var milestone milestone
+		if err := c.Decode(&milestone); err != nil {
+			return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		}
+		milestones = append(milestones, milestone.toEntity())
+	}
+
+	return milestones, http.StatusOK, nil
+}
+
+// UpdatePublished to mark milestone as published.
+func (m *Mongo) UpdatePublished(ctx context.Context, data entity.Milestone) (int, error) {
+	if _, err := m.db.UpdateOne(ctx,
+		m.getKey(data.VtuberID, data.ChannelID, data.ChannelType, data.Milestone),
+		bson.M{"$set": bson.M{"is_published": true}},
+	); err != nil {
+		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+	return http.StatusOK, nil
+}
+
+func (m *Mongo) getKey(vtuberID int64, channelID string, channelType vtuberEntity.ChannelType, ms int) bson.M {
+	return bson.M{
+		"vtuber_id":    vtuberID,
+		"channel_id":   channelID,
+		"channel_type": channelType,
+		"milestone":    ms,
+	}
+}
+
+// GetAll to get milestones.
+func (m *Mongo) GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity.Milestone, int, int, error) {
+	query := bson.M{}
+	opt := options.Find().SetSort(bson.D{{Key: "reached_at", Value: -1}, {Key: "milestone", Value: -1}}).SetSkip(int64((data.Page - 1) * data.Limit)).SetLimit(int64(data.Limit))
+
+	if data.VtuberID > 0 {
+		query["vtuber_id"] = data.VtuberID
+	}
+
+	if data.ChannelType != "" {
+		query["channel_type"] = data.ChannelType
+	}
+
+	if data.MinMilestone > 0 {
+		query["milestone"] = bson.M{"$gte": data.MinMilestone}
+	}
+
+	if data.StartDate != nil {
+		query["reached_at"] = bson.M{"$gte": data.StartDate}
+	}
+
+	if data.Limit < 0 {
+		opt.SetLimit(0)
+	}
+
+	c, err := m.db.Find(ctx, query, opt)
+	if err != nil {
+		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+	defer c.Close(ctx)
+
+	var milestones []entity.Milestone
+	for c.Next(ctx) {
+		var milestone milestone
+		if err := c.Decode(&milestone); err != nil {
+			return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		}
+		milestones = append(milestones, milestone.toEntity())
+	}
+
+	total, err := m.db.CountDocuments(ctx, query, options.Count())
+	if err != nil {
+		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	return milestones, int(total), http.StatusOK, nil
+}
+
+// GetNext to get the lowest configured milestone
+// above the subscriber. Return 0 if there is none.
+func (m *Mongo) GetNext(ctx context.Context, subscriber int) (int, int, error) {
+	var next int
+	for _, ms := range m.milestones {
+		if ms > subscriber && (next == 0 || ms < next) {
+			next = ms
+		}
+	}
+	return next, http.StatusOK, nil
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/rl404/shimakaze/internal/domain/milestone/entity"
+)
+
+// Repository contains functions for milestone domain.
+type Repository interface {
+	CreateCrossed(ctx context.Context, data entity.CreateCrossedRequest) (int, error)
+	GetUnpublished(ctx context.Context, data entity.GetUnpublishedRequest) ([]entity.Milestone, int, error)
+	UpdatePublished(ctx context.Context, data entity.Milestone) (int, error)
+	GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity.Milestone, int, int, error)
+	GetNext(ctx context.Context, subscriber int) (int, int, error)
+}
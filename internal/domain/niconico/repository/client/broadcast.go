@@ -67,6 +67,8 @@ func (c *Client) GetBroadcasts(ctx context.Context, id string) ([]entity.Video,
 			return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
 		}
 
+		c.limiter.Take()
+
 		resp, err := c.http.Do(req)
 		if err != nil {
 			return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
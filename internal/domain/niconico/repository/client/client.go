@@ -5,21 +5,25 @@ import (
 	"time"
 
 	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/rl404/fairy/limit"
+	"github.com/rl404/fairy/limit/mutex"
 )
 
 // Client is niconico api client.
 type Client struct {
-	http   *http.Client
-	maxAge time.Time
+	http    *http.Client
+	maxAge  time.Time
+	limiter limit.Limiter
 }
 
 // New to create new niconico api client.
-func New(maxAge int) *Client {
+func New(maxAge, rateLimit int) *Client {
 	return &Client{
 		http: &http.Client{
 			Timeout:   10 * time.Second,
 			Transport: newrelic.NewRoundTripper(http.DefaultTransport),
 		},
-		maxAge: time.Now().Add(time.Duration(maxAge*-24) * time.Hour),
+		maxAge:  time.Now().Add(time.Duration(maxAge*-24) * time.Hour),
+		limiter: mutex.New(rateLimit, time.Second),
 	}
 }
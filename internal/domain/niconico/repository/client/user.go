@@ -41,6 +41,8 @@ func (c *Client) GetUser(ctx context.Context, userURL string) (*entity.User, int
 		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
 	}
 
+	c.limiter.Take()
+
 	resp, err := c.http.Do(req)
 	if err != nil {
 		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
@@ -59,6 +59,8 @@ func (c *Client) GetVideos(ctx context.Context, id string) ([]entity.Video, int,
 
 		req.Header.Add("X-Frontend-id", "6")
 
+		c.limiter.Take()
+
 		resp, err := c.http.Do(req)
 		if err != nil {
 			return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
@@ -8,7 +8,8 @@ type NonVtuber struct {
 
 // GetAllRequest is get all request model.
 type GetAllRequest struct {
-	Name  string
-	Page  int
-	Limit int
+	Name   string
+	Page   int
+	Limit  int
+	Cursor string
 }
@@ -61,29 +61,30 @@ func (c *Cache) Create(ctx context.Context, id int64, name string) (int, error)
 }
 
 type getAllCache struct {
-	Data  []entity.NonVtuber
-	Total int
+	Data       []entity.NonVtuber
+	Total      int
+	NextCursor string
 }
 
 // GetAll to get non-vtuber list.
-func (c *Cache) GetAll(ctx context.Context, req entity.GetAllRequest) (_ []entity.NonVtuber, _ int, code int, err error) {
+func (c *Cache) GetAll(ctx context.Context, req entity.GetAllRequest) (_ []entity.NonVtuber, _ int, _ string, code int, err error) {
 	key := utils.GetKey("non-vtuber", utils.QueryToKey(req))
 
 	var data getAllCache
 	if c.cacher.Get(ctx, key, &data) == nil {
-		return data.Data, 0, http.StatusOK, nil
+		return data.Data, data.Total, data.NextCursor, http.StatusOK, nil
 	}
 
-	data.Data, data.Total, code, err = c.repo.GetAll(ctx, req)
+	data.Data, data.Total, data.NextCursor, code, err = c.repo.GetAll(ctx, req)
 	if err != nil {
-		return nil, 0, code, stack.Wrap(ctx, err)
+		return nil, 0, "", code, stack.Wrap(ctx, err)
 	}
 
 	if err := c.cacher.Set(ctx, key, data); err != nil {
-		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalCache)
+		return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalCache)
 	}
 
-	return data.Data, data.Total, code, nil
+	return data.Data, data.Total, data.NextCursor, code, nil
 }
 
 // DeleteByID to delete by id.
@@ -7,6 +7,7 @@ import (
 	"github.com/rl404/fairy/errors/stack"
 	"github.com/rl404/shimakaze/internal/domain/non_vtuber/entity"
 	"github.com/rl404/shimakaze/internal/errors"
+	"github.com/rl404/shimakaze/internal/utils"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
@@ -56,9 +57,10 @@ func (m *Mongo) GetAllIDs(ctx context.Context) ([]int64, int, error) {
 }
 
 // GetAll to get list.
-func (m *Mongo) GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity.NonVtuber, int, int, error) {
+func (m *Mongo) GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity.NonVtuber, int, string, int, error) {
 	query := bson.M{}
-	opt := options.Find().SetSort(bson.D{{Key: "name", Value: 1}}).SetSkip(int64((data.Page - 1) * data.Limit)).SetLimit(int64(data.Limit))
+	sort := bson.D{{Key: "name", Value: 1}, {Key: "id", Value: 1}}
+	opt := options.Find().SetSort(sort).SetSkip(int64((data.Page - 1) * data.Limit)).SetLimit(int64(data.Limit))
 
 	if data.Name != "" {
 		query = bson.M{"name": bson.M{"$regex": data.Name, "$options": "i"}}
@@ -68,27 +70,50 @@ func (m *Mongo) GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity
 		opt.SetLimit(0)
 	}
 
-	c, err := m.db.Find(ctx, query, opt)
+	findQuery := query
+	if data.Cursor != "" {
+		values, err := utils.DecodeCursor(data.Cursor, sort)
+		if err != nil {
+			return nil, 0, "", http.StatusBadRequest, stack.Wrap(ctx, err)
+		}
+
+		findQuery = bson.M{"$and": bson.A{query, utils.GetCursorFilter(sort, values)}}
+		opt.SetSkip(0)
+	}
+
+	c, err := m.db.Find(ctx, findQuery, opt)
 	if err != nil {
-		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
 	}
 	defer c.Close(ctx)
 
+	var last bson.Raw
 	var nonVtubers []entity.NonVtuber
 	for c.Next(ctx) {
 		var nonVtuber nonVtuber
 		if err := c.Decode(&nonVtuber); err != nil {
-			return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+			return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
 		}
 		nonVtubers = append(nonVtubers, nonVtuber.toEntity())
+		last = append(last[:0], c.Current...)
+	}
+
+	var nextCursor string
+	if data.Limit > 0 && len(nonVtubers) == data.Limit {
+		nextCursor = utils.EncodeCursor(sort, last)
+	}
+
+	// Total is only counted in page mode.
+	if data.Cursor != "" {
+		return nonVtubers, 0, nextCursor, http.StatusOK, nil
 	}
 
 	total, err := m.db.CountDocuments(ctx, query, options.Count())
 	if err != nil {
-		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
 	}
 
-	return nonVtubers, int(total), http.StatusOK, nil
+	return nonVtubers, int(total), nextCursor, http.StatusOK, nil
 }
 
 // DeleteByID to delete by id.
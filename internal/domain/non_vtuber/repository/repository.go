@@ -10,6 +10,6 @@ import (
 type Repository interface {
 	Create(ctx context.Context, id int64, name string) (int, error)
 	GetAllIDs(ctx context.Context) ([]int64, int, error)
-	GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity.NonVtuber, int, int, error)
+	GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity.NonVtuber, int, string, int, error)
 	DeleteByID(ctx context.Context, id int64) (int, error)
 }
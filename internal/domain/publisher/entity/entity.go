@@ -1,16 +1,34 @@
 package entity
 
+import "time"
+
 type messageType string
 
 // Available message types.
 const (
-	TypeParseVtuber messageType = "parse-vtuber"
-	TypeParseAgency messageType = "parse-agency"
+	TypeParseVtuber    messageType = "parse-vtuber"
+	TypeParseAgency    messageType = "parse-agency"
+	TypeRefreshChannel messageType = "refresh-channel"
 )
 
 // Message is pubsub message.
 type Message struct {
-	Type   messageType `json:"type"`
-	ID     int64       `json:"id"`
-	Forced bool        `json:"forced"`
+	Type      messageType `json:"type"`
+	ID        int64       `json:"id"`
+	Forced    bool        `json:"forced"`
+	ChannelID string      `json:"channel_id,omitempty"`
+}
+
+// MilestoneMessage is pubsub message for
+// channel subscriber milestone.
+type MilestoneMessage struct {
+	VtuberID    int64     `json:"vtuber_id"`
+	VtuberName  string    `json:"vtuber_name"`
+	VtuberImage string    `json:"vtuber_image"`
+	ChannelID   string    `json:"channel_id"`
+	ChannelName string    `json:"channel_name"`
+	ChannelType string    `json:"channel_type"`
+	Milestone   int       `json:"milestone"`
+	Subscriber  int       `json:"subscriber"`
+	ReachedAt   time.Time `json:"reached_at"`
 }
@@ -12,15 +12,17 @@ import (
 
 // Pubsub contains functions for pubsub.
 type Pubsub struct {
-	pubsub pubsub.PubSub
-	topic  string
+	pubsub         pubsub.PubSub
+	topic          string
+	milestoneTopic string
 }
 
 // New to create new pubsub.
-func New(ps pubsub.PubSub, topic string) *Pubsub {
+func New(ps pubsub.PubSub, topic, milestoneTopic string) *Pubsub {
 	return &Pubsub{
-		pubsub: ps,
-		topic:  topic,
+		pubsub:         ps,
+		topic:          topic,
+		milestoneTopic: milestoneTopic,
 	}
 }
 
@@ -59,3 +61,35 @@ func (p *Pubsub) PublishParseAgency(ctx context.Context, id int64, forced bool)
 
 	return nil
 }
+
+// PublishRefreshChannel to publish refresh channel.
+func (p *Pubsub) PublishRefreshChannel(ctx context.Context, id int64, channelID string) error {
+	msg, err := json.Marshal(entity.Message{
+		Type:      entity.TypeRefreshChannel,
+		ID:        id,
+		ChannelID: channelID,
+	})
+	if err != nil {
+		return stack.Wrap(ctx, err, errors.ErrInternalServer)
+	}
+
+	if err := p.pubsub.Publish(ctx, p.topic, msg); err != nil {
+		return stack.Wrap(ctx, err, errors.ErrInternalServer)
+	}
+
+	return nil
+}
+
+// PublishMilestone to publish channel milestone.
+func (p *Pubsub) PublishMilestone(ctx context.Context, data entity.MilestoneMessage) error {
+	msg, err := json.Marshal(data)
+	if err != nil {
+		return stack.Wrap(ctx, err, errors.ErrInternalServer)
+	}
+
+	if err := p.pubsub.Publish(ctx, p.milestoneTopic, msg); err != nil {
+		return stack.Wrap(ctx, err, errors.ErrInternalServer)
+	}
+
+	return nil
+}
@@ -2,10 +2,14 @@ package repository
 
 import (
 	"context"
+
+	"github.com/rl404/shimakaze/internal/domain/publisher/entity"
 )
 
 // Repository contains functions for publisher domain.
 type Repository interface {
 	PublishParseVtuber(ctx context.Context, id int64, forced bool) error
 	PublishParseAgency(ctx context.Context, id int64, forced bool) error
+	PublishRefreshChannel(ctx context.Context, id int64, channelID string) error
+	PublishMilestone(ctx context.Context, data entity.MilestoneMessage) error
 }
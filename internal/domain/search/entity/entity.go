@@ -0,0 +1,41 @@
+package entity
+
+// Vtuber is entity for vtuber search document.
+type Vtuber struct {
+	ID            int64
+	Name          string
+	OriginalNames []string
+	Nicknames     []string
+}
+
+// Result is entity for search result.
+type Result struct {
+	ID    int64
+	Score float64
+}
+
+// SuggestionType is type of suggestion.
+type SuggestionType string
+
+// Available suggestion types.
+const (
+	SuggestionVtuber             SuggestionType = "VTUBER"
+	SuggestionAgency             SuggestionType = "AGENCY"
+	SuggestionCharacterDesigner  SuggestionType = "CHARACTER_DESIGNER"
+	SuggestionCharacter2DModeler SuggestionType = "CHARACTER_2D_MODELER"
+	SuggestionCharacter3DModeler SuggestionType = "CHARACTER_3D_MODELER"
+)
+
+// Suggestion is entity for autocomplete suggestion.
+//
+// Name is the displayed name while Keywords are all
+// the names to be matched. Popularity is used to rank
+// suggestions with the same match quality.
+type Suggestion struct {
+	Type       SuggestionType
+	ID         int64
+	Name       string
+	Image      string
+	Keywords   []string
+	Popularity int
+}
@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rl404/fairy/cache"
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/search/entity"
+	"github.com/rl404/shimakaze/internal/domain/search/repository"
+	"github.com/rl404/shimakaze/internal/errors"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+// Cache contains functions for search cache.
+//
+// Index is kept in each server process, so the stale
+// mark is shared through cache as a version to let
+// other processes know the index should be rebuilt.
+type Cache struct {
+	sync.Mutex
+	cacher  cache.Cacher
+	repo    repository.Repository
+	version int64
+}
+
+// New to create new search cache.
+func New(cacher cache.Cacher, repo repository.Repository) *Cache {
+	return &Cache{
+		cacher: cacher,
+		repo:   repo,
+	}
+}
+
+// SetVtubers to rebuild vtuber index.
+func (c *Cache) SetVtubers(ctx context.Context, data []entity.Vtuber) (int, error) {
+	return c.repo.SetVtubers(ctx, data)
+}
+
+// SearchVtubers to search vtuber index.
+func (c *Cache) SearchVtubers(ctx context.Context, query string, limit int) ([]entity.Result, int, error) {
+	return c.repo.SearchVtubers(ctx, query, limit)
+}
+
+// SetSuggestions to rebuild suggestion index.
+func (c *Cache) SetSuggestions(ctx context.Context, data []entity.Suggestion) (int, error) {
+	return c.repo.SetSuggestions(ctx, data)
+}
+
+// Suggest to get suggestions.
+func (c *Cache) Suggest(ctx context.Context, query string, limit int) ([]entity.Suggestion, int, error) {
+	return c.repo.Suggest(ctx, query, limit)
+}
+
+// SetStale to mark the index as stale.
+func (c *Cache) SetStale(ctx context.Context) (int, error) {
+	if code, err := c.repo.SetStale(ctx); err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	if err := c.cacher.Set(ctx, utils.GetKey("search", "version"), time.Now().UnixNano()); err != nil {
+		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalCache)
+	}
+
+	return http.StatusOK, nil
+}
+
+// IsStale to check if the index is marked as stale
+// since the last check, by this or other processes.
+func (c *Cache) IsStale(ctx context.Context) (bool, int, error) {
+	stale, code, err := c.repo.IsStale(ctx)
+	if err != nil {
+		return false, code, stack.Wrap(ctx, err)
+	}
+
+	var version int64
+	if c.cacher.Get(ctx, utils.GetKey("search", "version"), &version) != nil {
+		return stale, http.StatusOK, nil
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if version != c.version {
+		c.version = version
+		stale = true
+	}
+
+	return stale, http.StatusOK, nil
+}
@@ -0,0 +1,71 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rl404/fairy/cache/inmemory"
+	"github.com/rl404/fairy/cache/nop"
+	"github.com/rl404/shimakaze/internal/domain/search/repository/memory"
+)
+
+func TestIsStale(t *testing.T) {
+	ctx := context.Background()
+
+	c, err := inmemory.New(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Server and consumer processes sharing the cache.
+	server := New(c, memory.New())
+	consumer := New(c, memory.New())
+
+	if stale, _, _ := server.IsStale(ctx); stale {
+		t.Fatal("new index should not be stale")
+	}
+
+	if _, err := consumer.SetStale(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if stale, _, _ := server.IsStale(ctx); !stale {
+		t.Fatal("index should be stale after other process update")
+	}
+
+	if stale, _, _ := server.IsStale(ctx); stale {
+		t.Fatal("index should not be stale after checked")
+	}
+
+	if _, err := server.SetStale(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if stale, _, _ := server.IsStale(ctx); !stale {
+		t.Fatal("index should be stale after own update")
+	}
+}
+
+func TestIsStaleNoCache(t *testing.T) {
+	ctx := context.Background()
+
+	c, err := nop.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := New(c, memory.New())
+
+	if _, err := server.SetStale(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if stale, _, _ := server.IsStale(ctx); !stale {
+		t.Fatal("index should be stale after own update without cache")
+	}
+
+	if stale, _, _ := server.IsStale(ctx); stale {
+		t.Fatal("index should not be stale after checked")
+	}
+}
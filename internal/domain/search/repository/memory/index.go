@@ -0,0 +1,214 @@
+package memory
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/rl404/shimakaze/internal/domain/search/entity"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+const (
+	nameWeight         = 3
+	originalNameWeight = 2
+	nicknameWeight     = 1
+)
+
+const (
+	exactScore  = 1
+	prefixScore = 0.75
+	fuzzyScore  = 0.6
+)
+
+// index is a simple inverted index.
+//
+// Each token points to the documents containing it with
+// the highest field weight. Sorted token list is used
+// for prefix lookup and fuzzy scan.
+type index struct {
+	postings map[string]map[int64]float64
+	tokens   []string
+}
+
+func newIndex() *index {
+	return &index{postings: make(map[string]map[int64]float64)}
+}
+
+func (idx *index) add(id int64, text string, weight float64) {
+	for _, token := range tokenize(text) {
+		if idx.postings[token] == nil {
+			idx.postings[token] = make(map[int64]float64)
+		}
+
+		if idx.postings[token][id] < weight {
+			idx.postings[token][id] = weight
+		}
+	}
+}
+
+func (idx *index) build() {
+	idx.tokens = make([]string, 0, len(idx.postings))
+	for token := range idx.postings {
+		idx.tokens = append(idx.tokens, token)
+	}
+	sort.Strings(idx.tokens)
+}
+
+// search to get documents matching all query terms.
+// Each term takes its best exact, prefix, or fuzzy
+// match score in the document.
+func (idx *index) search(query string, limit int) []entity.Result {
+	terms := tokenize(query)
+	if len(terms) == 0 {
+		return []entity.Result{}
+	}
+
+	var scores map[int64]float64
+	for _, term := range terms {
+		termScores := idx.searchTerm(term)
+
+		if scores == nil {
+			scores = termScores
+			continue
+		}
+
+		for id := range scores {
+			if termScores[id] == 0 {
+				delete(scores, id)
+				continue
+			}
+			scores[id] += termScores[id]
+		}
+	}
+
+	res := make([]entity.Result, 0, len(scores))
+	for id, score := range scores {
+		res = append(res, entity.Result{ID: id, Score: score})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+		return res[i].ID < res[j].ID
+	})
+
+	if limit > 0 && len(res) > limit {
+		res = res[:limit]
+	}
+
+	return res
+}
+
+func (idx *index) searchTerm(term string) map[int64]float64 {
+	scores := make(map[int64]float64)
+
+	// Exact and prefix.
+	for i := sort.SearchStrings(idx.tokens, term); i < len(idx.tokens) && strings.HasPrefix(idx.tokens[i], term); i++ {
+		if idx.tokens[i] == term {
+			idx.setScore(scores, idx.tokens[i], exactScore)
+			continue
+		}
+		idx.setScore(scores, idx.tokens[i], prefixScore)
+	}
+
+	// Fuzzy.
+	maxDistance := getMaxDistance(term)
+	if maxDistance == 0 {
+		return scores
+	}
+
+	termRunes := []rune(term)
+	for _, token := range idx.tokens {
+		tokenRunes := []rune(token)
+		if abs(len(tokenRunes)-len(termRunes)) > maxDistance {
+			continue
+		}
+
+		distance := getDistance(termRunes, tokenRunes, maxDistance)
+		if distance == 0 || distance > maxDistance {
+			continue
+		}
+
+		idx.setScore(scores, token, fuzzyScore/float64(distance))
+	}
+
+	return scores
+}
+
+// setScore to keep the best score of each document
+// containing the token.
+func (idx *index) setScore(scores map[int64]float64, token string, score float64) {
+	for id, weight := range idx.postings[token] {
+		if scores[id] < weight*score {
+			scores[id] = weight * score
+		}
+	}
+}
+
+func tokenize(text string) []string {
+	return strings.FieldsFunc(utils.NormalizeSearchText(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// getMaxDistance to get allowed typo count.
+// Short term is too ambiguous to be fuzzy matched.
+func getMaxDistance(term string) int {
+	switch l := len([]rune(term)); {
+	case l >= 8:
+		return 2
+	case l >= 4:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// getDistance to get optimal string alignment distance
+// between 2 strings, so adjacent transposition counts as
+// 1 typo. Return max+1 early if the distance exceeds max.
+func getDistance(a, b []rune, max int) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+
+			rowMin = min(rowMin, curr[j])
+		}
+
+		if rowMin > max {
+			return max + 1
+		}
+
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	return prev[len(b)]
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
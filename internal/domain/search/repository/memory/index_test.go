@@ -0,0 +1,53 @@
+package memory
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rl404/shimakaze/internal/domain/search/entity"
+)
+
+func TestIndexSearch(t *testing.T) {
+	idx := newIndex()
+	idx.add(1, "Usada Pekora", nameWeight)
+	idx.add(1, "Peko", nicknameWeight)
+	idx.add(2, "Houshou Marine", nameWeight)
+	idx.add(2, "宝鐘マリン", originalNameWeight)
+	idx.add(3, "Pekoyama Ken", nameWeight)
+	idx.build()
+
+	tests := []struct {
+		name  string
+		query string
+		limit int
+		want  []entity.Result
+	}{
+		{name: "empty", query: "", want: []entity.Result{}},
+		{name: "symbols-only", query: " !! ", want: []entity.Result{}},
+		{name: "no-match", query: "xyz", want: []entity.Result{}},
+		{name: "exact", query: "pekora", want: []entity.Result{{ID: 1, Score: 3}}},
+		{name: "case-insensitive", query: "PEKORA", want: []entity.Result{{ID: 1, Score: 3}}},
+		{name: "prefix-beats-lighter-exact", query: "peko", want: []entity.Result{{ID: 1, Score: 2.25}, {ID: 3, Score: 2.25}}},
+		{name: "short-prefix", query: "pek", want: []entity.Result{{ID: 1, Score: 2.25}, {ID: 3, Score: 2.25}}},
+		{name: "limit", query: "peko", limit: 1, want: []entity.Result{{ID: 1, Score: 2.25}}},
+		{name: "all-terms", query: "usada pekora", want: []entity.Result{{ID: 1, Score: 6}}},
+		{name: "missing-term", query: "usada marine", want: []entity.Result{}},
+		{name: "transposition", query: "pekroa", want: []entity.Result{{ID: 1, Score: 1.8}}},
+		{name: "katakana", query: "マリン", want: []entity.Result{{ID: 2, Score: 2.25}}},
+		{name: "kanji", query: "宝鐘", want: []entity.Result{{ID: 2, Score: 2}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := idx.search(tt.query, tt.limit)
+			if len(got) != len(tt.want) {
+				t.Fatalf("search() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].ID != tt.want[i].ID || math.Abs(got[i].Score-tt.want[i].Score) > 1e-9 {
+					t.Fatalf("search() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/rl404/shimakaze/internal/domain/search/entity"
+)
+
+// Memory contains functions for in-memory search index.
+type Memory struct {
+	sync.RWMutex
+	vtuber     *index
+	suggestion *suggestionIndex
+	stale      bool
+}
+
+// New to create new in-memory search index.
+func New() *Memory {
+	return &Memory{
+		vtuber:     newIndex(),
+		suggestion: newSuggestionIndex(nil),
+	}
+}
+
+// SetVtubers to rebuild vtuber index.
+func (m *Memory) SetVtubers(ctx context.Context, data []entity.Vtuber) (int, error) {
+	idx := newIndex()
+	for _, vtuber := range data {
+		idx.add(vtuber.ID, vtuber.Name, nameWeight)
+
+		for _, name := range vtuber.OriginalNames {
+			idx.add(vtuber.ID, name, originalNameWeight)
+		}
+
+		for _, name := range vtuber.Nicknames {
+			idx.add(vtuber.ID, name, nicknameWeight)
+		}
+	}
+	idx.build()
+
+	m.Lock()
+	defer m.Unlock()
+	m.vtuber = idx
+
+	return http.StatusOK, nil
+}
+
+// SearchVtubers to search vtuber index.
+func (m *Memory) SearchVtubers(ctx context.Context, query string, limit int) ([]entity.Result, int, error) {
+	m.RLock()
+	idx := m.vtuber
+	m.RUnlock()
+	return idx.search(query, limit), http.StatusOK, nil
+}
+
+// SetSuggestions to rebuild suggestion index.
+func (m *Memory) SetSuggestions(ctx context.Context, data []entity.Suggestion) (int, error) {
+	idx := newSuggestionIndex(data)
+
+	m.Lock()
+	defer m.Unlock()
+	m.suggestion = idx
+
+	return http.StatusOK, nil
+}
+
+// Suggest to get suggestions with name prefixed by the query.
+func (m *Memory) Suggest(ctx context.Context, query string, limit int) ([]entity.Suggestion, int, error) {
+	m.RLock()
+	idx := m.suggestion
+	m.RUnlock()
+	return idx.suggest(query, limit), http.StatusOK, nil
+}
+
+// SetStale to mark the index as stale.
+func (m *Memory) SetStale(ctx context.Context) (int, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.stale = true
+	return http.StatusOK, nil
+}
+
+// IsStale to check if the index is marked as stale
+// since the last check.
+func (m *Memory) IsStale(ctx context.Context) (bool, int, error) {
+	m.Lock()
+	defer m.Unlock()
+	stale := m.stale
+	m.stale = false
+	return stale, http.StatusOK, nil
+}
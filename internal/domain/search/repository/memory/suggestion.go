@@ -0,0 +1,107 @@
+package memory
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/rl404/shimakaze/internal/domain/search/entity"
+)
+
+const (
+	exactSuggestionScore  = 3
+	prefixSuggestionScore = 2
+	wordSuggestionScore   = 1
+)
+
+type suggestionKey struct {
+	key    string
+	item   int
+	isWord bool
+}
+
+// suggestionIndex is a prefix index of suggestions.
+//
+// Each keyword is indexed from the start of every word,
+// so "usada pekora" can be found by "usa" and "pek".
+type suggestionIndex struct {
+	items []entity.Suggestion
+	keys  []suggestionKey
+}
+
+func newSuggestionIndex(items []entity.Suggestion) *suggestionIndex {
+	idx := &suggestionIndex{items: items}
+	for i, item := range items {
+		for _, keyword := range item.Keywords {
+			words := tokenize(keyword)
+			for j := range words {
+				idx.keys = append(idx.keys, suggestionKey{
+					key:    strings.Join(words[j:], " "),
+					item:   i,
+					isWord: j > 0,
+				})
+			}
+		}
+	}
+
+	sort.Slice(idx.keys, func(i, j int) bool {
+		return idx.keys[i].key < idx.keys[j].key
+	})
+
+	return idx
+}
+
+// suggest to get suggestions matching the query prefix.
+// Exact match comes first, then keyword prefix match, then
+// word prefix match. Same score is ordered by popularity.
+func (idx *suggestionIndex) suggest(query string, limit int) []entity.Suggestion {
+	q := strings.Join(tokenize(query), " ")
+	if q == "" {
+		return []entity.Suggestion{}
+	}
+
+	scores := make(map[int]int)
+	start := sort.Search(len(idx.keys), func(i int) bool { return idx.keys[i].key >= q })
+	for i := start; i < len(idx.keys) && strings.HasPrefix(idx.keys[i].key, q); i++ {
+		score := wordSuggestionScore
+		if !idx.keys[i].isWord {
+			score = prefixSuggestionScore
+			if idx.keys[i].key == q {
+				score = exactSuggestionScore
+			}
+		}
+
+		if scores[idx.keys[i].item] < score {
+			scores[idx.keys[i].item] = score
+		}
+	}
+
+	items := make([]int, 0, len(scores))
+	for item := range scores {
+		items = append(items, item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		a, b := idx.items[items[i]], idx.items[items[j]]
+		if scores[items[i]] != scores[items[j]] {
+			return scores[items[i]] > scores[items[j]]
+		}
+		if a.Popularity != b.Popularity {
+			return a.Popularity > b.Popularity
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Type < b.Type
+	})
+
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+
+	res := make([]entity.Suggestion, len(items))
+	for i, item := range items {
+		res[i] = idx.items[item]
+	}
+
+	return res
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/rl404/shimakaze/internal/domain/search/entity"
+)
+
+// Repository contains functions for search domain.
+type Repository interface {
+	SetVtubers(ctx context.Context, data []entity.Vtuber) (int, error)
+	SearchVtubers(ctx context.Context, query string, limit int) ([]entity.Result, int, error)
+	SetSuggestions(ctx context.Context, data []entity.Suggestion) (int, error)
+	Suggest(ctx context.Context, query string, limit int) ([]entity.Suggestion, int, error)
+	SetStale(ctx context.Context) (int, error)
+	IsStale(ctx context.Context) (bool, int, error)
+}
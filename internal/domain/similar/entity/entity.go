@@ -0,0 +1,15 @@
+package entity
+
+// Similar is entity for similar vtuber.
+type Similar struct {
+	ID                 int64
+	Name               string
+	Image              string
+	Score              float64
+	AgencyScore        float64
+	LanguageScore      float64
+	CreatorScore       float64
+	DebutScore         float64
+	StreamingHourScore float64
+	SubscriberScore    float64
+}
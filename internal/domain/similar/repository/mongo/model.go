@@ -0,0 +1,56 @@
+package mongo
+
+import (
+	"time"
+
+	"github.com/rl404/shimakaze/internal/domain/similar/entity"
+)
+
+type vtuberSimilar struct {
+	VtuberID  int64     `bson:"vtuber_id"`
+	Similars  []similar `bson:"similars"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+type similar struct {
+	ID                 int64   `bson:"id"`
+	Name               string  `bson:"name"`
+	Image              string  `bson:"image"`
+	Score              float64 `bson:"score"`
+	AgencyScore        float64 `bson:"agency_score"`
+	LanguageScore      float64 `bson:"language_score"`
+	CreatorScore       float64 `bson:"creator_score"`
+	DebutScore         float64 `bson:"debut_score"`
+	StreamingHourScore float64 `bson:"streaming_hour_score"`
+	SubscriberScore    float64 `bson:"subscriber_score"`
+}
+
+func (s *similar) toEntity() entity.Similar {
+	return entity.Similar{
+		ID:                 s.ID,
+		Name:               s.Name,
+		Image:              s.Image,
+		Score:              s.Score,
+		AgencyScore:        s.AgencyScore,
+		LanguageScore:      s.LanguageScore,
+		CreatorScore:       s.CreatorScore,
+		DebutScore:         s.DebutScore,
+		StreamingHourScore: s.StreamingHourScore,
+		SubscriberScore:    s.SubscriberScore,
+	}
+}
+
+func (m *Mongo) similarFromEntity(s entity.Similar) similar {
+	return similar{
+		ID:                 s.ID,
+		Name:               s.Name,
+		Image:              s.Image,
+		Score:              s.Score,
+		AgencyScore:        s.AgencyScore,
+		LanguageScore:      s.LanguageScore,
+		CreatorScore:       s.CreatorScore,
+		DebutScore:         s.DebutScore,
+		StreamingHourScore: s.StreamingHourScore,
+		SubscriberScore:    s.SubscriberScore,
+	}
+}
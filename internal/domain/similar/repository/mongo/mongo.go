@@ -0,0 +1,63 @@
+package mongo
+
+import (
+	"context"
+	_errors "errors"
+	"net/http"
+	"time"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/similar/entity"
+	"github.com/rl404/shimakaze/internal/errors"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Mongo contains functions for similar vtuber mongodb.
+type Mongo struct {
+	db *mongo.Collection
+}
+
+// New to create new similar vtuber mongodb.
+func New(db *mongo.Database) *Mongo {
+	return &Mongo{
+		db: db.Collection("vtuber_similar"),
+	}
+}
+
+// GetByVtuberID to get similar vtubers by vtuber id.
+func (m *Mongo) GetByVtuberID(ctx context.Context, vtuberID int64) ([]entity.Similar, int, error) {
+	var vs vtuberSimilar
+	if err := m.db.FindOne(ctx, bson.M{"vtuber_id": vtuberID}).Decode(&vs); err != nil {
+		if _errors.Is(err, mongo.ErrNoDocuments) {
+			return []entity.Similar{}, http.StatusOK, nil
+		}
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	res := make([]entity.Similar, len(vs.Similars))
+	for i, s := range vs.Similars {
+		res[i] = s.toEntity()
+	}
+
+	return res, http.StatusOK, nil
+}
+
+// UpdateByVtuberID to update similar vtubers by vtuber id.
+func (m *Mongo) UpdateByVtuberID(ctx context.Context, vtuberID int64, data []entity.Similar) (int, error) {
+	similars := make([]similar, len(data))
+	for i, s := range data {
+		similars[i] = m.similarFromEntity(s)
+	}
+
+	if _, err := m.db.UpdateOne(ctx, bson.M{"vtuber_id": vtuberID}, bson.M{"$set": vtuberSimilar{
+		VtuberID:  vtuberID,
+		Similars:  similars,
+		UpdatedAt: time.Now(),
+	}}, options.UpdateOne().SetUpsert(true)); err != nil {
+		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	return http.StatusOK, nil
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/rl404/shimakaze/internal/domain/similar/entity"
+)
+
+// Repository contains functions for similar vtuber domain.
+type Repository interface {
+	GetByVtuberID(ctx context.Context, vtuberID int64) ([]entity.Similar, int, error)
+	UpdateByVtuberID(ctx context.Context, vtuberID int64, data []entity.Similar) (int, error)
+}
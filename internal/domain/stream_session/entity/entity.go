@@ -0,0 +1,17 @@
+package entity
+
+import (
+	"time"
+
+	vtuberEntity "github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+)
+
+// StreamSession is entity for stream session.
+type StreamSession struct {
+	ID          string
+	VtuberID    int64
+	ChannelID   string
+	ChannelType vtuberEntity.ChannelType
+	StartedAt   time.Time
+	EndedAt     *time.Time
+}
@@ -0,0 +1,31 @@
+package mongo
+
+import (
+	"time"
+
+	vtuberEntity "github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type streamSession struct {
+	ID          string                   `bson:"id"`
+	VtuberID    int64                    `bson:"vtuber_id"`
+	ChannelID   string                   `bson:"channel_id"`
+	ChannelType vtuberEntity.ChannelType `bson:"channel_type"`
+	StartedAt   time.Time                `bson:"started_at"`
+	EndedAt     *time.Time               `bson:"ended_at"`
+	CreatedAt   time.Time                `bson:"created_at"`
+	UpdatedAt   time.Time                `bson:"updated_at"`
+}
+
+// MarshalBSON to override marshal function.
+func (ss *streamSession) MarshalBSON() ([]byte, error) {
+	if ss.CreatedAt.IsZero() {
+		ss.CreatedAt = time.Now()
+	}
+
+	ss.UpdatedAt = time.Now()
+
+	type ss2 streamSession
+	return bson.Marshal((*ss2)(ss))
+}
@@ -0,0 +1,67 @@
+package mongo
+
+import (
+	"context"
+	_errors "errors"
+	"net/http"
+	"time"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/stream_session/entity"
+	vtuberEntity "github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/errors"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Mongo contains functions for stream-session mongodb.
+type Mongo struct {
+	db *mongo.Collection
+}
+
+// New to create new stream-session mongodb.
+func New(db *mongo.Database) *Mongo {
+	return &Mongo{
+		db: db.Collection("stream_session"),
+	}
+}
+
+// Start to start stream session.
+func (m *Mongo) Start(ctx context.Context, data entity.StreamSession) (int, error) {
+	filter := bson.M{"channel_type": data.ChannelType, "id": data.ID}
+
+	var session streamSession
+	if err := m.db.FindOne(ctx, filter).Decode(&session); err != nil {
+		if _errors.Is(err, mongo.ErrNoDocuments) {
+			if _, err := m.db.InsertOne(ctx, &streamSession{
+				ID:          data.ID,
+				VtuberID:    data.VtuberID,
+				ChannelID:   data.ChannelID,
+				ChannelType: data.ChannelType,
+				StartedAt:   data.StartedAt,
+			}); err != nil {
+				return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+			}
+			return http.StatusCreated, nil
+		}
+		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	// Already started.
+	return http.StatusOK, nil
+}
+
+// End to end all running stream sessions of the channel.
+func (m *Mongo) End(ctx context.Context, channelType vtuberEntity.ChannelType, channelID string, endedAt time.Time) (int, error) {
+	if _, err := m.db.UpdateMany(ctx, bson.M{
+		"channel_type": channelType,
+		"channel_id":   channelID,
+		"ended_at":     bson.M{"$eq": nil},
+	}, bson.M{"$set": bson.M{
+		"ended_at":   endedAt,
+		"updated_at": time.Now(),
+	}}); err != nil {
+		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+	return http.StatusOK, nil
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/rl404/shimakaze/internal/domain/stream_session/entity"
+	vtuberEntity "github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+)
+
+// Repository contains functions for stream-session domain.
+type Repository interface {
+	Start(ctx context.Context, data entity.StreamSession) (int, error)
+	End(ctx context.Context, channelType vtuberEntity.ChannelType, channelID string, endedAt time.Time) (int, error)
+}
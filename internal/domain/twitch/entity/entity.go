@@ -19,3 +19,57 @@ type Video struct {
 	StartDate *time.Time
 	EndDate   *time.Time
 }
+
+// ScheduleSegment is entity for schedule segment.
+type ScheduleSegment struct {
+	ID          string
+	Title       string
+	Category    string
+	StartDate   *time.Time
+	EndDate     *time.Time
+	IsRecurring bool
+}
+
+// Available eventsub message types.
+const (
+	EventSubMessageVerification = "webhook_callback_verification"
+	EventSubMessageNotification = "notification"
+	EventSubMessageRevocation   = "revocation"
+)
+
+// Available eventsub subscription types.
+const (
+	EventSubStreamOnline  = "stream.online"
+	EventSubStreamOffline = "stream.offline"
+)
+
+// Available eventsub revocation status.
+const (
+	EventSubRevokedUserRemoved      = "user_removed"
+	EventSubRevokedAuthorization    = "authorization_revoked"
+	EventSubRevokedFailuresExceeded = "notification_failures_exceeded"
+	EventSubRevokedVersionRemoved   = "version_removed"
+)
+
+// EventSubRequest is entity for eventsub webhook request.
+type EventSubRequest struct {
+	MessageID        string
+	MessageType      string
+	MessageTimestamp string
+	MessageSignature string
+	Body             []byte
+}
+
+// EventSub is entity for eventsub message.
+type EventSub struct {
+	MessageID        string
+	MessageType      string
+	SubscriptionType string
+	Challenge        string
+	BroadcasterID    string
+	StreamID         string
+	StartedAt        *time.Time
+	Status           string
+	Timestamp        time.Time
+	IsDuplicate      bool
+}
@@ -0,0 +1,105 @@
+package breaker
+
+import (
+	"context"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/twitch/entity"
+	"github.com/rl404/shimakaze/internal/domain/twitch/repository"
+	_breaker "github.com/rl404/shimakaze/pkg/breaker"
+)
+
+// Breaker contains functions for twitch circuit breaker.
+type Breaker struct {
+	breaker *_breaker.Breaker
+	repo    repository.Repository
+}
+
+// New to create new twitch circuit breaker.
+func New(breaker *_breaker.Breaker, repo repository.Repository) *Breaker {
+	return &Breaker{
+		breaker: breaker,
+		repo:    repo,
+	}
+}
+
+// GetUser to get user.
+func (b *Breaker) GetUser(ctx context.Context, name string) (data *entity.User, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetUser", func() (int, error) {
+		data, code, err = b.repo.GetUser(ctx, name)
+		return code, err
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
+
+// GetFollowerCount to get follower count.
+func (b *Breaker) GetFollowerCount(ctx context.Context, id string) (data int, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetFollowerCount", func() (int, error) {
+		data, code, err = b.repo.GetFollowerCount(ctx, id)
+		return code, err
+	})
+	if err != nil {
+		return 0, code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
+
+// GetVideos to get videos.
+func (b *Breaker) GetVideos(ctx context.Context, id string) (data []entity.Video, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetVideos", func() (int, error) {
+		data, code, err = b.repo.GetVideos(ctx, id)
+		return code, err
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
+
+// GetLiveStream to get live stream.
+func (b *Breaker) GetLiveStream(ctx context.Context, id string) (data *entity.Video, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetLiveStream", func() (int, error) {
+		data, code, err = b.repo.GetLiveStream(ctx, id)
+		return code, err
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
+
+// GetSchedule to get stream schedule.
+func (b *Breaker) GetSchedule(ctx context.Context, id string) (data []entity.ScheduleSegment, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetSchedule", func() (int, error) {
+		data, code, err = b.repo.GetSchedule(ctx, id)
+		return code, err
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
+
+// SubscribeStreamEvents to subscribe stream online & offline events.
+func (b *Breaker) SubscribeStreamEvents(ctx context.Context, id string) (int, error) {
+	code, err := b.breaker.Do(ctx, "SubscribeStreamEvents", func() (int, error) {
+		return b.repo.SubscribeStreamEvents(ctx, id)
+	})
+	if err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+	return code, nil
+}
+
+// ParseEventSub to verify and parse eventsub message.
+func (b *Breaker) ParseEventSub(ctx context.Context, data entity.EventSubRequest) (*entity.EventSub, int, error) {
+	return b.repo.ParseEventSub(ctx, data)
+}
+
+// SetEventSubHandled to mark eventsub message as handled.
+func (b *Breaker) SetEventSubHandled(ctx context.Context, messageID string) (int, error) {
+	return b.repo.SetEventSubHandled(ctx, messageID)
+}
@@ -7,17 +7,23 @@ import (
 	"github.com/newrelic/go-agent/v3/newrelic"
 	"github.com/nicklaw5/helix/v2"
 	"github.com/rl404/fairy/cache"
+	"github.com/rl404/fairy/limit"
+	"github.com/rl404/fairy/limit/mutex"
 )
 
 // Client is twitch api client.
 type Client struct {
-	cacher cache.Cacher
-	client *helix.Client
-	maxAge time.Time
+	cacher           cache.Cacher
+	client           *helix.Client
+	maxAge           time.Time
+	scheduleAge      time.Duration
+	eventSubCallback string
+	eventSubSecret   string
+	limiter          limit.Limiter
 }
 
 // New to create new twitch api client.
-func New(cacher cache.Cacher, clientID, clientSecret string, maxAge int) *Client {
+func New(cacher cache.Cacher, clientID, clientSecret string, maxAge, scheduleAge, rateLimit int, eventSubCallback, eventSubSecret string) *Client {
 	client, _ := helix.NewClient(&helix.Options{
 		ClientID:     clientID,
 		ClientSecret: clientSecret,
@@ -27,8 +33,12 @@ func New(cacher cache.Cacher, clientID, clientSecret string, maxAge int) *Client
 		},
 	})
 	return &Client{
-		cacher: cacher,
-		client: client,
-		maxAge: time.Now().Add(time.Duration(maxAge*-24) * time.Hour),
+		cacher:           cacher,
+		client:           client,
+		maxAge:           time.Now().Add(time.Duration(maxAge*-24) * time.Hour),
+		scheduleAge:      time.Duration(scheduleAge*24) * time.Hour,
+		eventSubCallback: eventSubCallback,
+		eventSubSecret:   eventSubSecret,
+		limiter:          mutex.New(rateLimit, time.Second),
 	}
 }
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	_errors "errors"
+	"net/http"
+	"time"
+
+	"github.com/nicklaw5/helix/v2"
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/twitch/entity"
+	"github.com/rl404/shimakaze/internal/errors"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+// Message older than this is rejected so
+// handled message id only needs to be kept this long.
+const eventSubMaxAge = 10 * time.Minute
+
+// SubscribeStreamEvents to subscribe stream online and offline events.
+func (c *Client) SubscribeStreamEvents(ctx context.Context, id string) (int, error) {
+	if code, err := c.setToken(ctx); err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	for _, t := range []string{entity.EventSubStreamOnline, entity.EventSubStreamOffline} {
+		c.limiter.Take()
+
+		resp, err := c.client.CreateEventSubSubscription(&helix.EventSubSubscription{
+			Type:      t,
+			Version:   "1",
+			Condition: helix.EventSubCondition{BroadcasterUserID: id},
+			Transport: helix.EventSubTransport{
+				Method:   "webhook",
+				Callback: c.eventSubCallback,
+				Secret:   c.eventSubSecret,
+			},
+		})
+		if err != nil {
+			return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
+		}
+
+		// Already subscribed.
+		if resp.StatusCode == http.StatusConflict {
+			continue
+		}
+
+		if resp.StatusCode >= http.StatusBadRequest {
+			return resp.StatusCode, stack.Wrap(ctx, _errors.New(resp.Error), _errors.New(resp.ErrorMessage))
+		}
+	}
+
+	return http.StatusAccepted, nil
+}
+
+type eventSubPayload struct {
+	Challenge    string `json:"challenge"`
+	Subscription struct {
+		Type      string `json:"type"`
+		Status    string `json:"status"`
+		Condition struct {
+			BroadcasterUserID string `json:"broadcaster_user_id"`
+		} `json:"condition"`
+	} `json:"subscription"`
+	Event struct {
+		ID                string `json:"id"`
+		BroadcasterUserID string `json:"broadcaster_user_id"`
+		StartedAt         string `json:"started_at"`
+	} `json:"event"`
+}
+
+// ParseEventSub to verify and parse eventsub webhook request.
+func (c *Client) ParseEventSub(ctx context.Context, data entity.EventSubRequest) (*entity.EventSub, int, error) {
+	if !c.isValidEventSubSignature(data) {
+		return nil, http.StatusForbidden, stack.Wrap(ctx, errors.ErrInvalidEventSubSignature)
+	}
+
+	// Reject old message to prevent replay attack.
+	timestamp, err := time.Parse(time.RFC3339Nano, data.MessageTimestamp)
+	if err != nil || time.Since(timestamp) > eventSubMaxAge {
+		return nil, http.StatusForbidden, stack.Wrap(ctx, errors.ErrInvalidEventSubSignature)
+	}
+
+	var payload eventSubPayload
+	if err := json.Unmarshal(data.Body, &payload); err != nil {
+		return nil, http.StatusBadRequest, stack.Wrap(ctx, err, errors.ErrInvalidRequestFormat)
+	}
+
+	// Twitch may resend the same message.
+	var handled bool
+	isDuplicate := c.cacher.Get(ctx, c.getEventSubKey(data.MessageID), &handled) == nil && handled
+
+	broadcasterID := payload.Event.BroadcasterUserID
+	if broadcasterID == "" {
+		broadcasterID = payload.Subscription.Condition.BroadcasterUserID
+	}
+
+	return &entity.EventSub{
+		MessageID:        data.MessageID,
+		MessageType:      data.MessageType,
+		SubscriptionType: payload.Subscription.Type,
+		Challenge:        payload.Challenge,
+		BroadcasterID:    broadcasterID,
+		StreamID:         payload.Event.ID,
+		StartedAt:        c.getStartDate(payload.Event.StartedAt),
+		Status:           payload.Subscription.Status,
+		Timestamp:        timestamp,
+		IsDuplicate:      isDuplicate,
+	}, http.StatusOK, nil
+}
+
+// SetEventSubHandled to mark eventsub message as handled.
+func (c *Client) SetEventSubHandled(ctx context.Context, messageID string) (int, error) {
+	if err := c.cacher.Set(ctx, c.getEventSubKey(messageID), true, eventSubMaxAge); err != nil {
+		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalCache)
+	}
+	return http.StatusOK, nil
+}
+
+func (c *Client) getEventSubKey(messageID string) string {
+	return utils.GetKey("twitch", "eventsub", messageID)
+}
+
+func (c *Client) isValidEventSubSignature(data entity.EventSubRequest) bool {
+	mac := hmac.New(sha256.New, []byte(c.eventSubSecret))
+	mac.Write([]byte(data.MessageID + data.MessageTimestamp))
+	mac.Write(data.Body)
+	return hmac.Equal([]byte("sha256="+hex.EncodeToString(mac.Sum(nil))), []byte(data.MessageSignature))
+}
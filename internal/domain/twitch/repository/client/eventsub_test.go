@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rl404/fairy/cache/inmemory"
+	"github.com/rl404/shimakaze/internal/domain/twitch/entity"
+)
+
+const testEventSubBody = `{
+  "subscription": {"type": "stream.online", "status": "enabled", "condition": {"broadcaster_user_id": "123"}},
+  "event": {"id": "stream-1", "broadcaster_user_id": "123", "started_at": "2024-01-02T03:04:05Z"}
+}`
+
+func newEventSubRequest(secret, id string, timestamp time.Time, body string) entity.EventSubRequest {
+	ts := timestamp.Format(time.RFC3339Nano)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(id + ts + body))
+	return entity.EventSubRequest{
+		MessageID:        id,
+		MessageType:      entity.EventSubMessageNotification,
+		MessageTimestamp: ts,
+		MessageSignature: "sha256=" + hex.EncodeToString(mac.Sum(nil)),
+		Body:             []byte(body),
+	}
+}
+
+func newTestClient(t *testing.T) *Client {
+	c, err := inmemory.New(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Client{cacher: c, eventSubSecret: "secret-123"}
+}
+
+func TestParseEventSub(t *testing.T) {
+	c := newTestClient(t)
+	now := time.Now()
+
+	tampered := newEventSubRequest("secret-123", "msg-1", now, testEventSubBody)
+	tampered.Body = append(tampered.Body, ' ')
+
+	unsigned := newEventSubRequest("secret-123", "msg-1", now, testEventSubBody)
+	unsigned.MessageSignature = ""
+
+	tests := []struct {
+		name     string
+		req      entity.EventSubRequest
+		wantCode int
+	}{
+		{name: "valid", req: newEventSubRequest("secret-123", "msg-1", now, testEventSubBody), wantCode: http.StatusOK},
+		{name: "unsigned", req: unsigned, wantCode: http.StatusForbidden},
+		{name: "wrong-secret", req: newEventSubRequest("other", "msg-1", now, testEventSubBody), wantCode: http.StatusForbidden},
+		{name: "tampered-body", req: tampered, wantCode: http.StatusForbidden},
+		{name: "expired", req: newEventSubRequest("secret-123", "msg-1", now.Add(-eventSubMaxAge-time.Minute), testEventSubBody), wantCode: http.StatusForbidden},
+		{name: "invalid-json", req: newEventSubRequest("secret-123", "msg-1", now, "{"), wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, code, _ := c.ParseEventSub(context.Background(), tt.req)
+			if code != tt.wantCode {
+				t.Fatalf("code = %d, want %d", code, tt.wantCode)
+			}
+
+			if code != http.StatusOK {
+				return
+			}
+
+			if res.BroadcasterID != "123" || res.StreamID != "stream-1" || res.SubscriptionType != entity.EventSubStreamOnline {
+				t.Fatalf("unexpected event %+v", res)
+			}
+			if res.StartedAt == nil || !res.StartedAt.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)) {
+				t.Fatalf("StartedAt = %v", res.StartedAt)
+			}
+		})
+	}
+}
+
+func TestParseEventSubDuplicate(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+	req := newEventSubRequest("secret-123", "msg-1", time.Now(), testEventSubBody)
+
+	res, _, err := c.ParseEventSub(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.IsDuplicate {
+		t.Fatal("first message is duplicate")
+	}
+
+	// Not handled yet (e.g. failed) so the retry is processed.
+	if res, _, _ = c.ParseEventSub(ctx, req); res.IsDuplicate {
+		t.Fatal("unhandled message is duplicate")
+	}
+
+	if _, err := c.SetEventSubHandled(ctx, req.MessageID); err != nil {
+		t.Fatal(err)
+	}
+
+	if res, _, _ = c.ParseEventSub(ctx, req); !res.IsDuplicate {
+		t.Fatal("handled message is not duplicate")
+	}
+
+	other := newEventSubRequest("secret-123", "msg-2", time.Now(), testEventSubBody)
+	if res, _, _ = c.ParseEventSub(ctx, other); res.IsDuplicate {
+		t.Fatal("other message is duplicate")
+	}
+}
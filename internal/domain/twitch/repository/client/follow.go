@@ -16,6 +16,8 @@ func (c *Client) GetFollowerCount(ctx context.Context, id string) (int, int, err
 		return 0, code, stack.Wrap(ctx, err)
 	}
 
+	c.limiter.Take()
+
 	resp, err := c.client.GetChannelFollows(&helix.GetChannelFollowsParams{
 		BroadcasterID: id,
 		First:         1,
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	_errors "errors"
+	"net/http"
+	"time"
+
+	"github.com/nicklaw5/helix/v2"
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/twitch/entity"
+	"github.com/rl404/shimakaze/internal/errors"
+)
+
+// GetSchedule to get upcoming schedule segments.
+func (c *Client) GetSchedule(ctx context.Context, id string) ([]entity.ScheduleSegment, int, error) {
+	if code, err := c.setToken(ctx); err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	maxDate := time.Now().Add(c.scheduleAge)
+
+	var res []entity.ScheduleSegment
+	var cursor string
+	for {
+		c.limiter.Take()
+
+		resp, err := c.client.GetSchedule(&helix.GetScheduleParams{
+			BroadcasterID: id,
+			First:         25,
+			After:         cursor,
+		})
+		if err != nil {
+			if resp == nil {
+				return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
+			}
+			return nil, resp.StatusCode, stack.Wrap(ctx, _errors.New(resp.Error), _errors.New(resp.ErrorMessage))
+		}
+
+		// No schedule.
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, http.StatusOK, nil
+		}
+
+		if resp.StatusCode >= http.StatusBadRequest {
+			return nil, resp.StatusCode, stack.Wrap(ctx, _errors.New(resp.Error), _errors.New(resp.ErrorMessage))
+		}
+
+		vacation := resp.Data.Schedule.Vacation
+
+		var done bool
+		for _, s := range resp.Data.Schedule.Segments {
+			if s.StartTime.IsZero() || s.StartTime.After(maxDate) {
+				done = true
+				break
+			}
+
+			// Canceled segment.
+			if s.CanceledUntil != "" {
+				continue
+			}
+
+			// Segment during vacation will not happen.
+			if c.isOnVacation(s.StartTime, vacation) {
+				continue
+			}
+
+			res = append(res, entity.ScheduleSegment{
+				ID:          s.ID,
+				Title:       s.Title,
+				Category:    s.Category.Name,
+				StartDate:   c.getScheduleDate(s.StartTime),
+				EndDate:     c.getScheduleDate(s.EndTime),
+				IsRecurring: s.IsRecurring,
+			})
+		}
+
+		if resp.Data.Pagination.Cursor == "" || done {
+			break
+		}
+
+		cursor = resp.Data.Pagination.Cursor
+	}
+
+	return res, http.StatusOK, nil
+}
+
+func (c *Client) isOnVacation(t helix.Time, vacation helix.GetScheduleVacation) bool {
+	if vacation.StartTime.IsZero() || vacation.EndTime.IsZero() {
+		return false
+	}
+	return !t.Before(vacation.StartTime.Time) && t.Before(vacation.EndTime.Time)
+}
+
+func (c *Client) getScheduleDate(t helix.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t.Time
+}
@@ -18,6 +18,8 @@ func (c *Client) GetLiveStream(ctx context.Context, id string) (*entity.Video, i
 		return nil, code, stack.Wrap(ctx, err)
 	}
 
+	c.limiter.Take()
+
 	resp, err := c.client.GetStreams(&helix.StreamsParams{
 		UserIDs: []string{id},
 		First:   1,
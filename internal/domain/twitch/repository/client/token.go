@@ -21,6 +21,8 @@ func (c *Client) setToken(ctx context.Context) (int, error) {
 		return http.StatusOK, nil
 	}
 
+	c.limiter.Take()
+
 	// Request token.
 	resp, err := c.client.RequestAppAccessToken([]string{})
 	if err != nil {
@@ -17,6 +17,8 @@ func (c *Client) GetUser(ctx context.Context, name string) (*entity.User, int, e
 		return nil, code, stack.Wrap(ctx, err)
 	}
 
+	c.limiter.Take()
+
 	resp, err := c.client.GetUsers(&helix.UsersParams{Logins: []string{name}})
 	if err != nil {
 		if resp == nil {
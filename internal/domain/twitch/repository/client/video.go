@@ -23,6 +23,8 @@ func (c *Client) GetVideos(ctx context.Context, id string) ([]entity.Video, int,
 	var res []entity.Video
 	var cursor string
 	for {
+		c.limiter.Take()
+
 		resp, err := c.client.GetVideos(&helix.VideosParams{
 			UserID: id,
 			First:  100,
@@ -12,4 +12,8 @@ type Repository interface {
 	GetFollowerCount(ctx context.Context, id string) (int, int, error)
 	GetVideos(ctx context.Context, id string) ([]entity.Video, int, error)
 	GetLiveStream(ctx context.Context, id string) (*entity.Video, int, error)
+	GetSchedule(ctx context.Context, id string) ([]entity.ScheduleSegment, int, error)
+	SubscribeStreamEvents(ctx context.Context, id string) (int, error)
+	ParseEventSub(ctx context.Context, data entity.EventSubRequest) (*entity.EventSub, int, error)
+	SetEventSubHandled(ctx context.Context, messageID string) (int, error)
 }
@@ -444,3 +444,12 @@ func StrsToChannelTypes(strs []string) []ChannelType {
 	}
 	return ct
 }
+
+// StrsToActivityStatuses to convert slice of string to slice of ActivityStatus.
+func StrsToActivityStatuses(strs []string) []ActivityStatus {
+	statuses := make([]ActivityStatus, len(strs))
+	for i, str := range strs {
+		statuses[i] = ActivityStatus(str)
+	}
+	return statuses
+}
@@ -9,6 +9,7 @@ type Vtuber struct {
 	Image               string
 	OriginalNames       []string
 	Nicknames           []string
+	SearchKeys          []string
 	Caption             string
 	DebutDate           *time.Time
 	RetirementDate      *time.Time
@@ -22,10 +23,17 @@ type Vtuber struct {
 	Languages           []Language
 	Channels            []Channel
 	Subscriber          int
+	YoutubeSubscriber   int
+	TwitchSubscriber    int
+	BilibiliSubscriber  int
+	NiconicoSubscriber  int
+	TotalSubscriber     int
 	MonthlySubscriber   int
 	VideoCount          int
 	AverageVideoLength  int
 	TotalVideoLength    int
+	LastActivityDate    *time.Time
+	ActivityStatus      ActivityStatus
 	SocialMedias        []string
 	OfficialWebsites    []string
 	Gender              string
@@ -67,25 +75,76 @@ type Language struct {
 
 // Channel is entity for channel.
 type Channel struct {
-	ID         string
-	Name       string
-	Type       ChannelType
-	URL        string
-	Image      string
+	ID            string
+	Name          string
+	Type          ChannelType
+	URL           string
+	Image         string
+	Subscriber    int
+	ViewCount     int
+	VideoCount    int
+	IsLive        bool
+	IsStale       bool
+	Videos        []Video
+	LastSuccessAt *time.Time
+	LastError     string
+	LastErrorCode int
+	FailCount     int
+	Growth        ChannelGrowth
+}
+
+// ChannelGrowth is entity for channel subscriber growth.
+type ChannelGrowth struct {
+	Day7   Growth
+	Day30  Growth
+	Day365 Growth
+}
+
+// Growth is entity for subscriber growth in a period.
+type Growth struct {
 	Subscriber int
-	Videos     []Video
+	Percent    float64
 }
 
 // Video is entity for video.
 type Video struct {
-	ID        string
-	Title     string
-	URL       string
-	Image     string
-	StartDate *time.Time
-	EndDate   *time.Time
+	ID               string
+	Title            string
+	URL              string
+	Image            string
+	StartDate        *time.Time
+	EndDate          *time.Time
+	ScheduledEndDate *time.Time
+	Category         string
+	IsRecurring      bool
+	IsScheduled      bool
 }
 
+// ActivityStatus is vtuber activity status inferred
+// from the last video or stream date.
+type ActivityStatus string
+
+// Available activity status.
+const (
+	ActivityActive  ActivityStatus = "ACTIVE"
+	ActivityHiatus  ActivityStatus = "HIATUS"
+	ActivityDormant ActivityStatus = "DORMANT"
+	ActivityRetired ActivityStatus = "RETIRED"
+)
+
+// SubscriberPlatform is platform of subscriber count.
+type SubscriberPlatform string
+
+// Available subscriber platforms. Empty means
+// the largest single channel subscriber.
+const (
+	SubscriberYoutube  SubscriberPlatform = "YOUTUBE"
+	SubscriberTwitch   SubscriberPlatform = "TWITCH"
+	SubscriberBilibili SubscriberPlatform = "BILIBILI"
+	SubscriberNiconico SubscriberPlatform = "NICONICO"
+	SubscriberTotal    SubscriberPlatform = "TOTAL"
+)
+
 // SearchMode is search mode.
 type SearchMode string
 
@@ -95,53 +154,136 @@ const (
 	SearchModeSimple SearchMode = "simple"
 )
 
+// MatchMode is how multi-value filter matches
+// array field.
+type MatchMode string
+
+// Available match modes.
+const (
+	MatchAny MatchMode = "any"
+	MatchAll MatchMode = "all"
+)
+
+// VideoMode is how channel videos are embedded.
+type VideoMode string
+
+// Available video modes.
+const (
+	VideoModeAll    VideoMode = "all"
+	VideoModeLatest VideoMode = "latest"
+	VideoModeNone   VideoMode = "none"
+)
+
+// Projection is vtuber field projection.
+// Empty fields means all fields, and
+// video limit is only for latest mode.
+type Projection struct {
+	Fields     []string
+	VideoMode  VideoMode
+	VideoLimit int
+}
+
+// FilterOperator is filter expression operator.
+type FilterOperator string
+
+// Available filter operators.
+const (
+	FilterAnd      FilterOperator = "AND"
+	FilterOr       FilterOperator = "OR"
+	FilterNot      FilterOperator = "NOT"
+	FilterEq       FilterOperator = "EQ"
+	FilterNe       FilterOperator = "NE"
+	FilterGt       FilterOperator = "GT"
+	FilterGte      FilterOperator = "GTE"
+	FilterLt       FilterOperator = "LT"
+	FilterLte      FilterOperator = "LTE"
+	FilterIn       FilterOperator = "IN"
+	FilterContains FilterOperator = "CONTAINS"
+)
+
+// Filter is entity for filter expression tree.
+// Logical operator (AND, OR, NOT) uses children,
+// while the others compare field with values.
+type Filter struct {
+	Operator FilterOperator
+	Field    string
+	Values   []interface{}
+	Children []Filter
+}
+
 // GetAllRequest is get all request model.
 type GetAllRequest struct {
-	Mode               SearchMode
-	Names              string
-	Name               string
-	OriginalName       string
-	Nickname           string
-	ExcludeActive      bool
-	ExcludeRetired     bool
-	DebutDay           int
-	StartDebutMonth    int
-	EndDebutMonth      int
-	StartDebutYear     int
-	EndDebutYear       int
-	StartRetiredMonth  int
-	EndRetiredMonth    int
-	StartRetiredYear   int
-	EndRetiredYear     int
-	Has2D              *bool
-	Has3D              *bool
-	CharacterDesigner  string
-	Character2DModeler string
-	Character3DModeler string
-	InAgency           *bool
-	Agency             string
-	AgencyID           int64
-	LanguageID         int64
-	ChannelTypes       []ChannelType
-	BirthdayDay        int
-	StartBirthdayMonth int
-	EndBirthdayMonth   int
-	BloodTypes         []string
-	Genders            []string
-	Zodiacs            []string
-	StartSubscriber    int
-	EndSubscriber      int
-	StartVideoCount    int
-	EndVideoCount      int
-	Sort               string
-	Page               int
-	Limit              int
+	Mode                       SearchMode
+	SearchIDs                  []int64
+	Names                      string
+	Name                       string
+	OriginalName               string
+	Nickname                   string
+	ExcludeActive              bool
+	ExcludeRetired             bool
+	DebutDay                   int
+	StartDebutMonth            int
+	EndDebutMonth              int
+	StartDebutYear             int
+	EndDebutYear               int
+	StartRetiredMonth          int
+	EndRetiredMonth            int
+	StartRetiredYear           int
+	EndRetiredYear             int
+	Has2D                      *bool
+	Has3D                      *bool
+	CharacterDesigner          string
+	CharacterDesigners         []string
+	ExcludeCharacterDesigners  []string
+	CharacterDesignerMatch     MatchMode
+	Character2DModeler         string
+	Character2DModelers        []string
+	ExcludeCharacter2DModelers []string
+	Character2DModelerMatch    MatchMode
+	Character3DModeler         string
+	Character3DModelers        []string
+	ExcludeCharacter3DModelers []string
+	Character3DModelerMatch    MatchMode
+	InAgency                   *bool
+	Agency                     string
+	AgencyID                   int64
+	AgencyIDs                  []int64
+	ExcludeAgencyIDs           []int64
+	AgencyMatch                MatchMode
+	LanguageID                 int64
+	LanguageIDs                []int64
+	ExcludeLanguageIDs         []int64
+	LanguageMatch              MatchMode
+	ChannelTypes               []ChannelType
+	ExcludeChannelTypes        []ChannelType
+	ChannelTypeMatch           MatchMode
+	ActivityStatuses           []ActivityStatus
+	BirthdayDay                int
+	StartBirthdayMonth         int
+	EndBirthdayMonth           int
+	BloodTypes                 []string
+	Genders                    []string
+	Zodiacs                    []string
+	StartSubscriber            int
+	EndSubscriber              int
+	SubscriberPlatform         SubscriberPlatform
+	StartVideoCount            int
+	EndVideoCount              int
+	Filter                     *Filter
+	Projection                 Projection
+	Sort                       string
+	Page                       int
+	Limit                      int
+	Cursor                     string
 }
 
 // StatusCount is entity for status count.
 type StatusCount struct {
-	Active  int
-	Retired int
+	Active          int
+	Retired         int
+	ActivityActive  int
+	ActivityHiatus  int
+	ActivityDormant int
 }
 
 // DebutRetireCount is entity for debut & retire count.
@@ -246,23 +388,73 @@ type GetVideosRequest struct {
 	Sort       string
 	Page       int
 	Limit      int
+	Cursor     string
 }
 
 // VtuberVideo is entity for vtuber video.
 type VtuberVideo struct {
-	VtuberID       int64
-	VtuberName     string
-	VtuberImage    string
-	ChannelID      string
-	ChannelName    string
+	VtuberID              int64
+	VtuberName            string
+	VtuberImage           string
+	ChannelID             string
+	ChannelName           string
+	ChannelType           ChannelType
+	ChannelURL            string
+	VideoID               string
+	VideoTitle            string
+	VideoURL              string
+	VideoImage            string
+	VideoStartDate        *time.Time
+	VideoEndDate          *time.Time
+	VideoScheduledEndDate *time.Time
+	VideoCategory         string
+	VideoIsRecurring      bool
+}
+
+// GetFailingChannelsRequest is get failing channels request model.
+type GetFailingChannelsRequest struct {
+	ChannelType  ChannelType
+	MinFailCount int
+	Page         int
+	Limit        int
+}
+
+// FailingChannel is entity for failing channel.
+type FailingChannel struct {
+	VtuberID      int64
+	VtuberName    string
+	ChannelID     string
+	ChannelName   string
+	ChannelType   ChannelType
+	ChannelURL    string
+	LastSuccessAt *time.Time
+	LastError     string
+	LastErrorCode int
+	FailCount     int
+}
+
+// GetSubscriberGrowthRequest is get subscriber growth request model.
+type GetSubscriberGrowthRequest struct {
 	ChannelType    ChannelType
-	ChannelURL     string
-	VideoID        string
-	VideoTitle     string
-	VideoURL       string
-	VideoImage     string
-	VideoStartDate *time.Time
-	VideoEndDate   *time.Time
+	AgencyID       int64
+	LanguageID     int64
+	ExcludeActive  bool
+	ExcludeRetired bool
+	Sort           string
+	Page           int
+	Limit          int
+}
+
+// SubscriberGrowth is entity for channel subscriber growth.
+type SubscriberGrowth struct {
+	VtuberID    int64
+	VtuberName  string
+	VtuberImage string
+	ChannelID   string
+	ChannelName string
+	ChannelType ChannelType
+	Subscriber  int
+	Growth      ChannelGrowth
 }
 
 // OverriddenField is entity for overridden fields.
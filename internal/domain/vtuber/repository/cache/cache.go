@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/rand"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/rl404/fairy/cache"
@@ -12,6 +13,7 @@ import (
 	"github.com/rl404/shimakaze/internal/domain/vtuber/repository"
 	"github.com/rl404/shimakaze/internal/errors"
 	"github.com/rl404/shimakaze/internal/utils"
+	_cache "github.com/rl404/shimakaze/pkg/cache"
 )
 
 // Cache contains functions for vtuber cache.
@@ -47,6 +49,93 @@ func (c *Cache) GetByID(ctx context.Context, id int64) (data *entity.Vtuber, cod
 	return data, code, nil
 }
 
+// GetByIDs to get data by ids. Data is cached per id
+// like GetByID, so only ids not in cache are fetched.
+func (c *Cache) GetByIDs(ctx context.Context, ids []int64) ([]entity.Vtuber, int, error) {
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = utils.GetKey("vtuber", id)
+	}
+
+	var res []entity.Vtuber
+	var missingIDs []int64
+	for i, data := range _cache.GetMulti[entity.Vtuber](ctx, c.cacher, keys) {
+		if data != nil {
+			res = append(res, *data)
+			continue
+		}
+		missingIDs = append(missingIDs, ids[i])
+	}
+
+	if len(missingIDs) == 0 {
+		return res, http.StatusOK, nil
+	}
+
+	vtubers, code, err := c.repo.GetByIDs(ctx, missingIDs)
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	// Cache error is only logged since the data is already fetched.
+	for _, vtuber := range vtubers {
+		if err := c.cacher.Set(ctx, utils.GetKey("vtuber", vtuber.ID), vtuber); err != nil {
+			utils.Error("failed to cache vtuber %d: %s", vtuber.ID, err.Error())
+		}
+		res = append(res, vtuber)
+	}
+
+	return res, http.StatusOK, nil
+}
+
+// GetProjectionByID to get data by id with projection.
+// Each projection is cached separately with the vtuber
+// cache version, so they are invalidated together with
+// the vtuber cache.
+func (c *Cache) GetProjectionByID(ctx context.Context, id int64, projection entity.Projection) (data *entity.Vtuber, code int, err error) {
+	projection.Fields = c.sortStrs(projection.Fields)
+	key := utils.GetKey("vtuber", id, c.getVersion(ctx, id), utils.QueryToKey(projection))
+	if c.cacher.Get(ctx, key, &data) == nil {
+		return data, http.StatusOK, nil
+	}
+
+	data, code, err = c.repo.GetProjectionByID(ctx, id, projection)
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	if err := c.cacher.Set(ctx, key, data, 5*time.Minute); err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalCache)
+	}
+
+	return data, code, nil
+}
+
+// getVersion to get vtuber cache version.
+func (c *Cache) getVersion(ctx context.Context, id int64) int64 {
+	var version int64
+	c.cacher.Get(ctx, utils.GetKey("vtuber", id, "version"), &version)
+	return version
+}
+
+// deleteByID to delete vtuber cache and
+// invalidate its projection cache.
+func (c *Cache) deleteByID(ctx context.Context, id int64) error {
+	if err := c.cacher.Delete(ctx, utils.GetKey("vtuber", id)); err != nil {
+		return err
+	}
+	return c.cacher.Set(ctx, utils.GetKey("vtuber", id, "version"), time.Now().UnixNano())
+}
+
+// GetAllForSearch to get all for search index.
+func (c *Cache) GetAllForSearch(ctx context.Context) ([]entity.Vtuber, int, error) {
+	return c.repo.GetAllForSearch(ctx)
+}
+
+// GetAllForSimilar to get all for similar vtuber.
+func (c *Cache) GetAllForSimilar(ctx context.Context) ([]entity.Vtuber, int, error) {
+	return c.repo.GetAllForSimilar(ctx)
+}
+
 // GetAllIDs to get all ids.
 func (c *Cache) GetAllIDs(ctx context.Context) ([]int64, int, error) {
 	return c.repo.GetAllIDs(ctx)
@@ -88,10 +177,8 @@ func (c *Cache) UpdateByID(ctx context.Context, id int64, data entity.Vtuber) (i
 		return code, stack.Wrap(ctx, err)
 	}
 
-	key := utils.GetKey("vtuber", id)
-	if err := c.cacher.Delete(ctx, key); err != nil {
+	if err := c.deleteByID(ctx, id); err != nil {
 		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalCache)
-
 	}
 
 	return http.StatusOK, nil
@@ -103,10 +190,8 @@ func (c *Cache) DeleteByID(ctx context.Context, id int64) (int, error) {
 		return code, stack.Wrap(ctx, err)
 	}
 
-	key := utils.GetKey("vtuber", id)
-	if err := c.cacher.Delete(ctx, key); err != nil {
+	if err := c.deleteByID(ctx, id); err != nil {
 		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalCache)
-
 	}
 
 	return http.StatusOK, nil
@@ -166,29 +251,78 @@ func (c *Cache) GetAllForAgencyTree(ctx context.Context) (data []entity.Vtuber,
 }
 
 type getAllCache struct {
-	Data  []entity.Vtuber
-	Total int
+	Data       []entity.Vtuber
+	Total      int
+	NextCursor string
 }
 
 // GetAll to get vtuber list.
-func (c *Cache) GetAll(ctx context.Context, req entity.GetAllRequest) (_ []entity.Vtuber, _ int, code int, err error) {
-	key := utils.GetKey("vtuber", utils.QueryToKey(req))
+func (c *Cache) GetAll(ctx context.Context, req entity.GetAllRequest) (_ []entity.Vtuber, _ int, _ string, code int, err error) {
+	key := c.getAllKey(req)
 
 	var data getAllCache
 	if c.cacher.Get(ctx, key, &data) == nil {
-		return data.Data, data.Total, http.StatusOK, nil
+		return data.Data, data.Total, data.NextCursor, http.StatusOK, nil
 	}
 
-	data.Data, data.Total, code, err = c.repo.GetAll(ctx, req)
+	data.Data, data.Total, data.NextCursor, code, err = c.repo.GetAll(ctx, req)
 	if err != nil {
-		return nil, 0, code, stack.Wrap(ctx, err)
+		return nil, 0, "", code, stack.Wrap(ctx, err)
 	}
 
 	if err := c.cacher.Set(ctx, key, data); err != nil {
-		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalCache)
+		return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalCache)
 	}
 
-	return data.Data, data.Total, code, nil
+	return data.Data, data.Total, data.NextCursor, code, nil
+}
+
+// getAllKey to get cache key of get all request.
+// Multi-value filters are sorted, so the same values
+// in different order share the same cache.
+func (c *Cache) getAllKey(req entity.GetAllRequest) string {
+	req.CharacterDesigners = c.sortStrs(req.CharacterDesigners)
+	req.ExcludeCharacterDesigners = c.sortStrs(req.ExcludeCharacterDesigners)
+	req.Character2DModelers = c.sortStrs(req.Character2DModelers)
+	req.ExcludeCharacter2DModelers = c.sortStrs(req.ExcludeCharacter2DModelers)
+	req.Character3DModelers = c.sortStrs(req.Character3DModelers)
+	req.ExcludeCharacter3DModelers = c.sortStrs(req.ExcludeCharacter3DModelers)
+	req.AgencyIDs = c.sortInt64s(req.AgencyIDs)
+	req.ExcludeAgencyIDs = c.sortInt64s(req.ExcludeAgencyIDs)
+	req.LanguageIDs = c.sortInt64s(req.LanguageIDs)
+	req.ExcludeLanguageIDs = c.sortInt64s(req.ExcludeLanguageIDs)
+	req.ChannelTypes = c.sortChannelTypes(req.ChannelTypes)
+	req.ExcludeChannelTypes = c.sortChannelTypes(req.ExcludeChannelTypes)
+	req.ActivityStatuses = c.sortActivityStatuses(req.ActivityStatuses)
+	req.BloodTypes = c.sortStrs(req.BloodTypes)
+	req.Genders = c.sortStrs(req.Genders)
+	req.Zodiacs = c.sortStrs(req.Zodiacs)
+	req.Projection.Fields = c.sortStrs(req.Projection.Fields)
+	return utils.GetKey("vtuber", utils.QueryToKey(req))
+}
+
+func (c *Cache) sortStrs(strs []string) []string {
+	sorted := append([]string(nil), strs...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func (c *Cache) sortInt64s(ints []int64) []int64 {
+	sorted := append([]int64(nil), ints...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+func (c *Cache) sortActivityStatuses(statuses []entity.ActivityStatus) []entity.ActivityStatus {
+	sorted := append([]entity.ActivityStatus(nil), statuses...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+func (c *Cache) sortChannelTypes(types []entity.ChannelType) []entity.ChannelType {
+	sorted := append([]entity.ChannelType(nil), types...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
 }
 
 // GetCharacterDesigners to get character designers.
@@ -254,37 +388,79 @@ func (c *Cache) UpdateOverriddenFieldByID(ctx context.Context, id int64, data en
 		return code, stack.Wrap(ctx, err)
 	}
 
-	key := utils.GetKey("vtuber", id)
-	if err := c.cacher.Delete(ctx, key); err != nil {
+	if err := c.deleteByID(ctx, id); err != nil {
 		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalCache)
+	}
+
+	return http.StatusOK, nil
+}
 
+// UpdateChannelLiveByID to update channel live state by id.
+func (c *Cache) UpdateChannelLiveByID(ctx context.Context, id int64, channelType entity.ChannelType, channelID string, isLive bool) (int, error) {
+	if code, err := c.repo.UpdateChannelLiveByID(ctx, id, channelType, channelID, isLive); err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	if err := c.deleteByID(ctx, id); err != nil {
+		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalCache)
 	}
 
 	return http.StatusOK, nil
 }
 
 type getVideosCache struct {
-	Data  []entity.VtuberVideo
-	Total int
+	Data       []entity.VtuberVideo
+	Total      int
+	NextCursor string
 }
 
 // GetVideos to get videos.
-func (c *Cache) GetVideos(ctx context.Context, req entity.GetVideosRequest) (_ []entity.VtuberVideo, _ int, code int, err error) {
+func (c *Cache) GetVideos(ctx context.Context, req entity.GetVideosRequest) (_ []entity.VtuberVideo, _ int, _ string, code int, err error) {
 	key := utils.GetKey("videos", utils.QueryToKey(req))
 
 	var data getVideosCache
 	if c.cacher.Get(ctx, key, &data) == nil {
-		return data.Data, data.Total, http.StatusOK, nil
+		return data.Data, data.Total, data.NextCursor, http.StatusOK, nil
 	}
 
-	data.Data, data.Total, code, err = c.repo.GetVideos(ctx, req)
+	data.Data, data.Total, data.NextCursor, code, err = c.repo.GetVideos(ctx, req)
 	if err != nil {
-		return nil, 0, code, stack.Wrap(ctx, err)
+		return nil, 0, "", code, stack.Wrap(ctx, err)
 	}
 
 	if err := c.cacher.Set(ctx, key, data, 5*time.Minute); err != nil {
-		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalCache)
+		return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalCache)
 	}
 
-	return data.Data, data.Total, code, nil
+	return data.Data, data.Total, data.NextCursor, code, nil
+}
+
+// GetFailingChannels to get channels failing to be fetched.
+func (c *Cache) GetFailingChannels(ctx context.Context, req entity.GetFailingChannelsRequest) ([]entity.FailingChannel, int, int, error) {
+	return c.repo.GetFailingChannels(ctx, req)
+}
+
+// CreateSnapshot to create vtuber snapshot.
+func (c *Cache) CreateSnapshot(ctx context.Context, data entity.Vtuber) (int, error) {
+	return c.repo.CreateSnapshot(ctx, data)
+}
+
+// GetSnapshotByID to get latest vtuber snapshot before the time.
+func (c *Cache) GetSnapshotByID(ctx context.Context, id int64, asOf time.Time) (*entity.Vtuber, int, error) {
+	return c.repo.GetSnapshotByID(ctx, id, asOf)
+}
+
+// GetSnapshotsByAgencyID to get latest snapshot of agency members before the time.
+func (c *Cache) GetSnapshotsByAgencyID(ctx context.Context, agencyID int64, asOf time.Time) ([]entity.Vtuber, int, error) {
+	return c.repo.GetSnapshotsByAgencyID(ctx, agencyID, asOf)
+}
+
+// GetIDByChannelID to get vtuber id by channel id.
+func (c *Cache) GetIDByChannelID(ctx context.Context, channelType entity.ChannelType, channelID string) (int64, int, error) {
+	return c.repo.GetIDByChannelID(ctx, channelType, channelID)
+}
+
+// GetActiveChannelIDs to get active vtuber channel ids.
+func (c *Cache) GetActiveChannelIDs(ctx context.Context, channelType entity.ChannelType) ([]string, int, error) {
+	return c.repo.GetActiveChannelIDs(ctx, channelType)
 }
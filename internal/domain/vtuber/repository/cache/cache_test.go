@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rl404/fairy/cache/inmemory"
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/domain/vtuber/repository"
+)
+
+type stubRepository struct {
+	repository.Repository
+	vtuber entity.Vtuber
+}
+
+func (r *stubRepository) GetProjectionByID(ctx context.Context, id int64, projection entity.Projection) (*entity.Vtuber, int, error) {
+	vtuber := r.vtuber
+	return &vtuber, http.StatusOK, nil
+}
+
+func (r *stubRepository) UpdateByID(ctx context.Context, id int64, data entity.Vtuber) (int, error) {
+	r.vtuber = data
+	return http.StatusOK, nil
+}
+
+func (r *stubRepository) DeleteByID(ctx context.Context, id int64) (int, error) {
+	r.vtuber = entity.Vtuber{}
+	return http.StatusOK, nil
+}
+
+func TestGetProjectionByIDInvalidated(t *testing.T) {
+	ctx := context.Background()
+
+	c, err := inmemory.New(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := &stubRepository{vtuber: entity.Vtuber{ID: 1, Name: "old"}}
+	cache := New(c, repo)
+	projection := entity.Projection{Fields: []string{"name"}, VideoMode: entity.VideoModeNone}
+
+	get := func() string {
+		vtuber, _, err := cache.GetProjectionByID(ctx, 1, projection)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return vtuber.Name
+	}
+
+	if got := get(); got != "old" {
+		t.Fatalf("GetProjectionByID() = %q, want %q", got, "old")
+	}
+
+	repo.vtuber.Name = "stale"
+	if got := get(); got != "old" {
+		t.Fatalf("GetProjectionByID() = %q, want cached %q", got, "old")
+	}
+
+	if _, err := cache.UpdateByID(ctx, 1, entity.Vtuber{ID: 1, Name: "new"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := get(); got != "new" {
+		t.Fatalf("GetProjectionByID() after update = %q, want %q", got, "new")
+	}
+
+	if _, err := cache.DeleteByID(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := get(); got != "" {
+		t.Fatalf("GetProjectionByID() after delete = %q, want empty", got)
+	}
+}
@@ -144,13 +144,13 @@ func (c *Cache) GetInAgencyCount(ctx context.Context) (data *entity.InAgencyCoun
 }
 
 // GetSubscriberCount to get subscriber count.
-func (c *Cache) GetSubscriberCount(ctx context.Context, interval, max int) (data []entity.SubscriberCount, code int, err error) {
-	key := utils.GetKey("vtuber", "stats", "subscriber-count", interval, max)
+func (c *Cache) GetSubscriberCount(ctx context.Context, interval, max int, platform entity.SubscriberPlatform) (data []entity.SubscriberCount, code int, err error) {
+	key := utils.GetKey("vtuber", "stats", "subscriber-count", interval, max, platform)
 	if c.cacher.Get(ctx, key, &data) == nil {
 		return data, http.StatusOK, nil
 	}
 
-	data, code, err = c.repo.GetSubscriberCount(ctx, interval, max)
+	data, code, err = c.repo.GetSubscriberCount(ctx, interval, max, platform)
 	if err != nil {
 		return nil, code, stack.Wrap(ctx, err)
 	}
@@ -465,3 +465,29 @@ func (c *Cache) GetZodiacCount(ctx context.Context) (data []entity.ZodiacCount,
 
 	return data, code, nil
 }
+
+type getSubscriberGrowthCache struct {
+	Data  []entity.SubscriberGrowth
+	Total int
+}
+
+// GetSubscriberGrowth to get channel subscriber growth.
+func (c *Cache) GetSubscriberGrowth(ctx context.Context, req entity.GetSubscriberGrowthRequest) (_ []entity.SubscriberGrowth, _ int, code int, err error) {
+	key := utils.GetKey("vtuber", "stats", "subscriber-growth", utils.QueryToKey(req))
+
+	var data getSubscriberGrowthCache
+	if c.cacher.Get(ctx, key, &data) == nil {
+		return data.Data, data.Total, http.StatusOK, nil
+	}
+
+	data.Data, data.Total, code, err = c.repo.GetSubscriberGrowth(ctx, req)
+	if err != nil {
+		return nil, 0, code, stack.Wrap(ctx, err)
+	}
+
+	if err := c.cacher.Set(ctx, key, data); err != nil {
+		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalCache)
+	}
+
+	return data.Data, data.Total, code, nil
+}
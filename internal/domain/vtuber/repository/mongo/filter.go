@@ -0,0 +1,87 @@
+package mongo
+
+import (
+	"regexp"
+
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+var filterFieldPaths = map[string]string{
+	"original_name":        "original_names",
+	"nickname":             "nicknames",
+	"character_designer":   "character_designers",
+	"character_2d_modeler": "character_2d_modelers",
+	"character_3d_modeler": "character_3d_modelers",
+	"agency":               "agencies.name",
+	"agency_id":            "agencies.id",
+	"language":             "languages.name",
+	"language_id":          "languages.id",
+	"channel_type":         "channels.type",
+}
+
+var filterOperators = map[entity.FilterOperator]string{
+	entity.FilterEq:  "$eq",
+	entity.FilterNe:  "$ne",
+	entity.FilterGt:  "$gt",
+	entity.FilterGte: "$gte",
+	entity.FilterLt:  "$lt",
+	entity.FilterLte: "$lte",
+}
+
+// getFilter to convert filter expression tree to mongo query.
+func (m *Mongo) getFilter(filter entity.Filter) bson.M {
+	switch filter.Operator {
+	case entity.FilterAnd, entity.FilterOr, entity.FilterNot:
+		children := make([]bson.M, len(filter.Children))
+		for i, child := range filter.Children {
+			children[i] = m.getFilter(child)
+		}
+
+		switch filter.Operator {
+		case entity.FilterAnd:
+			return bson.M{"$and": children}
+		case entity.FilterOr:
+			return bson.M{"$or": children}
+		default:
+			return bson.M{"$nor": children}
+		}
+
+	case entity.FilterContains:
+		value, _ := filter.Values[0].(string)
+		return bson.M{m.getFilterFieldPath(filter.Field): bson.M{"$regex": regexp.QuoteMeta(value), "$options": "i"}}
+	}
+
+	switch filter.Field {
+	case "retired":
+		value, _ := filter.Values[0].(bool)
+		if filter.Operator == entity.FilterNe {
+			value = !value
+		}
+
+		if value {
+			return bson.M{"retirement_date": bson.M{"$ne": nil}}
+		}
+		return bson.M{"retirement_date": bson.M{"$eq": nil}}
+
+	case "in_agency":
+		value, _ := filter.Values[0].(bool)
+		if filter.Operator == entity.FilterNe {
+			value = !value
+		}
+		return bson.M{"agencies.0": bson.M{"$exists": value}}
+	}
+
+	if filter.Operator == entity.FilterIn {
+		return bson.M{m.getFilterFieldPath(filter.Field): bson.M{"$in": filter.Values}}
+	}
+
+	return bson.M{m.getFilterFieldPath(filter.Field): bson.M{filterOperators[filter.Operator]: filter.Values[0]}}
+}
+
+func (m *Mongo) getFilterFieldPath(field string) string {
+	if path, ok := filterFieldPaths[field]; ok {
+		return path
+	}
+	return field
+}
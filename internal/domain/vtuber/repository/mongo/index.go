@@ -0,0 +1,23 @@
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// CreateIndexes to create vtuber collection indexes.
+func CreateIndexes(ctx context.Context, db *mongo.Database) error {
+	if _, err := db.Collection("vtuber").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "search_keys", Value: 1}},
+	}); err != nil {
+		return err
+	}
+
+	_, err := db.Collection("vtuber_snapshot").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "vtuber_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "vtuber.agencies.id", Value: 1}, {Key: "created_at", Value: -1}}},
+	})
+	return err
+}
@@ -1,6 +1,7 @@
 package mongo
 
 import (
+	"strings"
 	"time"
 
 	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
@@ -9,41 +10,49 @@ import (
 )
 
 type vtuber struct {
-	ID                  int64           `bson:"id"`
-	Name                string          `bson:"name"`
-	Image               string          `bson:"image"`
-	OriginalNames       []string        `bson:"original_names"`
-	Nicknames           []string        `bson:"nicknames"`
-	Caption             string          `bson:"caption"`
-	DebutDate           *time.Time      `bson:"debut_date"`
-	RetirementDate      *time.Time      `bson:"retirement_date"`
-	Has2D               bool            `bson:"has_2d"`
-	Has3D               bool            `bson:"has_3d"`
-	CharacterDesigners  []string        `bson:"character_designers"`
-	Character2DModelers []string        `bson:"character_2d_modelers"`
-	Character3DModelers []string        `bson:"character_3d_modelers"`
-	Agencies            []agency        `bson:"agencies"`
-	Affiliations        []string        `bson:"affiliations"`
-	Languages           []language      `bson:"languages"`
-	Channels            []channel       `bson:"channels"`
-	Subscriber          int             `bson:"subscriber"`
-	MonthlySubscriber   int             `bson:"monthly_subscriber"`
-	VideoCount          int             `bson:"video_count"`
-	AverageVideoLength  int             `bson:"average_video_length"`
-	TotalVideoLength    int             `bson:"total_video_length"`
-	SocialMedias        []string        `bson:"social_medias"`
-	OfficialWebsites    []string        `bson:"official_websites"`
-	Gender              string          `bson:"gender"`
-	Age                 *float64        `bson:"age"`
-	Birthday            *time.Time      `bson:"birthday"`
-	Height              *float64        `bson:"height"`
-	Weight              *float64        `bson:"weight"`
-	BloodType           string          `bson:"blood_type"`
-	ZodiacSign          string          `bson:"zodiac_sign"`
-	Emoji               string          `bson:"emoji"`
-	OverriddenField     overriddenField `bson:"overridden_field"`
-	CreatedAt           time.Time       `bson:"created_at"`
-	UpdatedAt           time.Time       `bson:"updated_at"`
+	ID                  int64                 `bson:"id"`
+	Name                string                `bson:"name"`
+	Image               string                `bson:"image"`
+	OriginalNames       []string              `bson:"original_names"`
+	Nicknames           []string              `bson:"nicknames"`
+	SearchKeys          []string              `bson:"search_keys"`
+	Caption             string                `bson:"caption"`
+	DebutDate           *time.Time            `bson:"debut_date"`
+	RetirementDate      *time.Time            `bson:"retirement_date"`
+	Has2D               bool                  `bson:"has_2d"`
+	Has3D               bool                  `bson:"has_3d"`
+	CharacterDesigners  []string              `bson:"character_designers"`
+	Character2DModelers []string              `bson:"character_2d_modelers"`
+	Character3DModelers []string              `bson:"character_3d_modelers"`
+	Agencies            []agency              `bson:"agencies"`
+	Affiliations        []string              `bson:"affiliations"`
+	Languages           []language            `bson:"languages"`
+	Channels            []channel             `bson:"channels"`
+	Subscriber          int                   `bson:"subscriber"`
+	YoutubeSubscriber   int                   `bson:"youtube_subscriber"`
+	TwitchSubscriber    int                   `bson:"twitch_subscriber"`
+	BilibiliSubscriber  int                   `bson:"bilibili_subscriber"`
+	NiconicoSubscriber  int                   `bson:"niconico_subscriber"`
+	TotalSubscriber     int                   `bson:"total_subscriber"`
+	MonthlySubscriber   int                   `bson:"monthly_subscriber"`
+	VideoCount          int                   `bson:"video_count"`
+	AverageVideoLength  int                   `bson:"average_video_length"`
+	TotalVideoLength    int                   `bson:"total_video_length"`
+	LastActivityDate    *time.Time            `bson:"last_activity_date"`
+	ActivityStatus      entity.ActivityStatus `bson:"activity_status"`
+	SocialMedias        []string              `bson:"social_medias"`
+	OfficialWebsites    []string              `bson:"official_websites"`
+	Gender              string                `bson:"gender"`
+	Age                 *float64              `bson:"age"`
+	Birthday            *time.Time            `bson:"birthday"`
+	Height              *float64              `bson:"height"`
+	Weight              *float64              `bson:"weight"`
+	BloodType           string                `bson:"blood_type"`
+	ZodiacSign          string                `bson:"zodiac_sign"`
+	Emoji               string                `bson:"emoji"`
+	OverriddenField     overriddenField       `bson:"overridden_field"`
+	CreatedAt           time.Time             `bson:"created_at"`
+	UpdatedAt           time.Time             `bson:"updated_at"`
 }
 
 type agency struct {
@@ -58,38 +67,89 @@ type language struct {
 }
 
 type channel struct {
-	ID         string             `bson:"id"`
-	Name       string             `bson:"name"`
-	Type       entity.ChannelType `bson:"type"`
-	URL        string             `bson:"url"`
-	Image      string             `bson:"image"`
-	Subscriber int                `bson:"subscriber"`
-	Videos     []video            `bson:"videos"`
+	ID            string             `bson:"id"`
+	Name          string             `bson:"name"`
+	Type          entity.ChannelType `bson:"type"`
+	URL           string             `bson:"url"`
+	Image         string             `bson:"image"`
+	Subscriber    int                `bson:"subscriber"`
+	ViewCount     int                `bson:"view_count"`
+	VideoCount    int                `bson:"video_count"`
+	IsLive        bool               `bson:"is_live"`
+	IsStale       bool               `bson:"is_stale"`
+	Videos        []video            `bson:"videos"`
+	LastSuccessAt *time.Time         `bson:"last_success_at"`
+	LastError     string             `bson:"last_error"`
+	LastErrorCode int                `bson:"last_error_code"`
+	FailCount     int                `bson:"fail_count"`
+	Growth        channelGrowth      `bson:"growth"`
+}
+
+type channelGrowth struct {
+	Day7   growth `bson:"day_7"`
+	Day30  growth `bson:"day_30"`
+	Day365 growth `bson:"day_365"`
+}
+
+type growth struct {
+	Subscriber int     `bson:"subscriber"`
+	Percent    float64 `bson:"percent"`
+}
+
+type subscriberGrowth struct {
+	VtuberID    int64              `bson:"vtuber_id"`
+	VtuberName  string             `bson:"vtuber_name"`
+	VtuberImage string             `bson:"vtuber_image"`
+	ChannelID   string             `bson:"channel_id"`
+	ChannelName string             `bson:"channel_name"`
+	ChannelType entity.ChannelType `bson:"channel_type"`
+	Subscriber  int                `bson:"subscriber"`
+	Growth      channelGrowth      `bson:"growth"`
 }
 
 type video struct {
-	ID        string     `bson:"id"`
-	Title     string     `bson:"title"`
-	URL       string     `bson:"url"`
-	Image     string     `bson:"image"`
-	StartDate *time.Time `bson:"start_date"`
-	EndDate   *time.Time `bson:"end_date"`
+	ID               string     `bson:"id"`
+	Title            string     `bson:"title"`
+	URL              string     `bson:"url"`
+	Image            string     `bson:"image"`
+	StartDate        *time.Time `bson:"start_date"`
+	EndDate          *time.Time `bson:"end_date"`
+	ScheduledEndDate *time.Time `bson:"scheduled_end_date"`
+	Category         string     `bson:"category"`
+	IsRecurring      bool       `bson:"is_recurring"`
+	IsScheduled      bool       `bson:"is_scheduled"`
 }
 
 type vtuberVideo struct {
-	VtuberID       int64              `bson:"vtuber_id"`
-	VtuberName     string             `bson:"vtuber_name"`
-	VtuberImage    string             `bson:"vtuber_image"`
-	ChannelID      string             `bson:"channel_id"`
-	ChannelName    string             `bson:"channel_name"`
-	ChannelType    entity.ChannelType `bson:"channel_type"`
-	ChannelURL     string             `bson:"channel_url"`
-	VideoID        string             `bson:"video_id"`
-	VideoTitle     string             `bson:"video_title"`
-	VideoURL       string             `bson:"video_url"`
-	VideoImage     string             `bson:"video_image"`
-	VideoStartDate *time.Time         `bson:"video_start_date"`
-	VideoEndDate   *time.Time         `bson:"video_end_date"`
+	VtuberID              int64              `bson:"vtuber_id"`
+	VtuberName            string             `bson:"vtuber_name"`
+	VtuberImage           string             `bson:"vtuber_image"`
+	ChannelID             string             `bson:"channel_id"`
+	ChannelName           string             `bson:"channel_name"`
+	ChannelType           entity.ChannelType `bson:"channel_type"`
+	ChannelURL            string             `bson:"channel_url"`
+	VideoID               string             `bson:"video_id"`
+	VideoTitle            string             `bson:"video_title"`
+	VideoURL              string             `bson:"video_url"`
+	VideoImage            string             `bson:"video_image"`
+	VideoStartDate        *time.Time         `bson:"video_start_date"`
+	VideoEndDate          *time.Time         `bson:"video_end_date"`
+	VideoScheduledEndDate *time.Time         `bson:"video_scheduled_end_date"`
+	VideoCategory         string             `bson:"video_category"`
+	VideoIsRecurring      bool               `bson:"video_is_recurring"`
+}
+
+type failingChannel struct {
+	VtuberID      int64              `bson:"vtuber_id"`
+	VtuberName    string             `bson:"vtuber_name"`
+	ChannelID     string             `bson:"channel_id"`
+	ChannelName   string             `bson:"channel_name"`
+	ChannelType   entity.ChannelType `bson:"channel_type"`
+	ChannelURL    string             `bson:"channel_url"`
+	LastSuccessAt *time.Time         `bson:"last_success_at"`
+	LastError     string             `bson:"last_error"`
+	LastErrorCode int                `bson:"last_error_code"`
+	FailCount     int                `bson:"fail_count"`
 }
 
 // MarshalBSON to override marshal function.
@@ -127,23 +187,36 @@ func (v *vtuber) toEntity() *entity.Vtuber {
 		videos := make([]entity.Video, len(c.Videos))
 		for j, vi := range c.Videos {
 			videos[j] = entity.Video{
-				ID:        vi.ID,
-				Title:     vi.Title,
-				URL:       vi.URL,
-				Image:     vi.Image,
-				StartDate: vi.StartDate,
-				EndDate:   vi.EndDate,
+				ID:               vi.ID,
+				Title:            vi.Title,
+				URL:              vi.URL,
+				Image:            vi.Image,
+				StartDate:        vi.StartDate,
+				EndDate:          vi.EndDate,
+				ScheduledEndDate: vi.ScheduledEndDate,
+				Category:         vi.Category,
+				IsRecurring:      vi.IsRecurring,
+				IsScheduled:      vi.IsScheduled,
 			}
 		}
 
 		channels[i] = entity.Channel{
-			ID:         c.ID,
-			Name:       c.Name,
-			Type:       c.Type,
-			URL:        c.URL,
-			Image:      c.Image,
-			Subscriber: c.Subscriber,
-			Videos:     videos,
+			ID:            c.ID,
+			Name:          c.Name,
+			Type:          c.Type,
+			URL:           c.URL,
+			Image:         c.Image,
+			Subscriber:    c.Subscriber,
+			ViewCount:     c.ViewCount,
+			VideoCount:    c.VideoCount,
+			IsLive:        c.IsLive,
+			IsStale:       c.IsStale,
+			Videos:        videos,
+			LastSuccessAt: c.LastSuccessAt,
+			LastError:     c.LastError,
+			LastErrorCode: c.LastErrorCode,
+			FailCount:     c.FailCount,
+			Growth:        c.Growth.toEntity(),
 		}
 	}
 
@@ -153,6 +226,7 @@ func (v *vtuber) toEntity() *entity.Vtuber {
 		Image:               v.Image,
 		OriginalNames:       v.OriginalNames,
 		Nicknames:           v.Nicknames,
+		SearchKeys:          v.SearchKeys,
 		Caption:             v.Caption,
 		DebutDate:           v.DebutDate,
 		RetirementDate:      v.RetirementDate,
@@ -166,10 +240,17 @@ func (v *vtuber) toEntity() *entity.Vtuber {
 		Languages:           languages,
 		Channels:            channels,
 		Subscriber:          v.Subscriber,
+		YoutubeSubscriber:   v.YoutubeSubscriber,
+		TwitchSubscriber:    v.TwitchSubscriber,
+		BilibiliSubscriber:  v.BilibiliSubscriber,
+		NiconicoSubscriber:  v.NiconicoSubscriber,
+		TotalSubscriber:     v.TotalSubscriber,
 		MonthlySubscriber:   v.MonthlySubscriber,
 		VideoCount:          v.VideoCount,
 		AverageVideoLength:  v.AverageVideoLength,
 		TotalVideoLength:    v.TotalVideoLength,
+		LastActivityDate:    v.LastActivityDate,
+		ActivityStatus:      v.ActivityStatus,
 		SocialMedias:        v.SocialMedias,
 		OfficialWebsites:    v.OfficialWebsites,
 		Gender:              v.Gender,
@@ -208,23 +289,36 @@ func (m *Mongo) vtuberFromEntity(v entity.Vtuber) *vtuber {
 		videos := make([]video, len(c.Videos))
 		for j, vid := range c.Videos {
 			videos[j] = video{
-				ID:        vid.ID,
-				Title:     vid.Title,
-				URL:       vid.URL,
-				Image:     vid.Image,
-				StartDate: vid.StartDate,
-				EndDate:   vid.EndDate,
+				ID:               vid.ID,
+				Title:            vid.Title,
+				URL:              vid.URL,
+				Image:            vid.Image,
+				StartDate:        vid.StartDate,
+				EndDate:          vid.EndDate,
+				ScheduledEndDate: vid.ScheduledEndDate,
+				Category:         vid.Category,
+				IsRecurring:      vid.IsRecurring,
+				IsScheduled:      vid.IsScheduled,
 			}
 		}
 
 		channels[i] = channel{
-			ID:         c.ID,
-			Name:       c.Name,
-			Type:       c.Type,
-			URL:        c.URL,
-			Image:      c.Image,
-			Subscriber: c.Subscriber,
-			Videos:     videos,
+			ID:            c.ID,
+			Name:          c.Name,
+			Type:          c.Type,
+			URL:           c.URL,
+			Image:         c.Image,
+			Subscriber:    c.Subscriber,
+			ViewCount:     c.ViewCount,
+			VideoCount:    c.VideoCount,
+			IsLive:        c.IsLive,
+			IsStale:       c.IsStale,
+			Videos:        videos,
+			LastSuccessAt: c.LastSuccessAt,
+			LastError:     c.LastError,
+			LastErrorCode: c.LastErrorCode,
+			FailCount:     c.FailCount,
+			Growth:        m.channelGrowthFromEntity(c.Growth),
 		}
 	}
 
@@ -234,6 +328,7 @@ func (m *Mongo) vtuberFromEntity(v entity.Vtuber) *vtuber {
 		Image:               v.Image,
 		OriginalNames:       v.OriginalNames,
 		Nicknames:           v.Nicknames,
+		SearchKeys:          v.SearchKeys,
 		Caption:             v.Caption,
 		DebutDate:           v.DebutDate,
 		RetirementDate:      v.RetirementDate,
@@ -247,10 +342,17 @@ func (m *Mongo) vtuberFromEntity(v entity.Vtuber) *vtuber {
 		Languages:           languages,
 		Channels:            channels,
 		Subscriber:          v.Subscriber,
+		YoutubeSubscriber:   v.YoutubeSubscriber,
+		TwitchSubscriber:    v.TwitchSubscriber,
+		BilibiliSubscriber:  v.BilibiliSubscriber,
+		NiconicoSubscriber:  v.NiconicoSubscriber,
+		TotalSubscriber:     v.TotalSubscriber,
 		MonthlySubscriber:   v.MonthlySubscriber,
 		VideoCount:          v.VideoCount,
 		AverageVideoLength:  v.AverageVideoLength,
 		TotalVideoLength:    v.TotalVideoLength,
+		LastActivityDate:    v.LastActivityDate,
+		ActivityStatus:      m.getActivityStatus(v.RetirementDate, v.LastActivityDate),
 		SocialMedias:        v.SocialMedias,
 		OfficialWebsites:    v.OfficialWebsites,
 		Gender:              v.Gender,
@@ -271,6 +373,9 @@ func (m *Mongo) convertSort(sort string) bson.D {
 	}
 
 	if sort[0] == '-' {
+		if sort[1:] == "activity_status" {
+			return bson.D{{Key: "activity_rank", Value: -1}, {Key: "last_activity_date", Value: 1}, {Key: "id", Value: 1}}
+		}
 		if sort[1:] == "video_count" {
 			return bson.D{{Key: sort[1:], Value: -1}, {Key: "retirement_date", Value: 1}, {Key: "id", Value: 1}}
 		}
@@ -281,6 +386,14 @@ func (m *Mongo) convertSort(sort string) bson.D {
 		return bson.D{{Key: sort, Value: 1}, {Key: "retirement_date", Value: -1}, {Key: "id", Value: 1}}
 	}
 
+	if sort == "relevance" {
+		return bson.D{{Key: "search_rank", Value: 1}, {Key: "id", Value: 1}}
+	}
+
+	if sort == "activity_status" {
+		return bson.D{{Key: "activity_rank", Value: 1}, {Key: "last_activity_date", Value: -1}, {Key: "id", Value: 1}}
+	}
+
 	if sort == "debut_date" {
 		return bson.D{{Key: "is_debut_date_null", Value: 1}, {Key: sort, Value: 1}, {Key: "id", Value: 1}}
 	}
@@ -288,15 +401,80 @@ func (m *Mongo) convertSort(sort string) bson.D {
 	return bson.D{{Key: sort, Value: 1}, {Key: "id", Value: 1}}
 }
 
-func (m *Mongo) getChannelTypeFilter(types []entity.ChannelType) bson.M {
+// getActivityStatus to get activity status from the
+// last video or stream date. Vtuber without any known
+// activity is considered dormant.
+func (m *Mongo) getActivityStatus(retirementDate, lastActivityDate *time.Time) entity.ActivityStatus {
+	if retirementDate != nil {
+		return entity.ActivityRetired
+	}
+
+	if lastActivityDate == nil {
+		return entity.ActivityDormant
+	}
+
+	switch age := time.Since(*lastActivityDate); {
+	case age <= m.hiatusAge:
+		return entity.ActivityActive
+	case age <= m.dormantAge:
+		return entity.ActivityHiatus
+	default:
+		return entity.ActivityDormant
+	}
+}
+
+func (m *Mongo) getSubscriberField(platform entity.SubscriberPlatform) string {
+	if platform == "" {
+		return "subscriber"
+	}
+	return strings.ToLower(string(platform)) + "_subscriber"
+}
+
+func (m *Mongo) convertGrowthSort(sort string) bson.D {
+	if sort == "" {
+		sort = "-growth_30d"
+	}
+
+	order := 1
+	if sort[0] == '-' {
+		order, sort = -1, sort[1:]
+	}
+
+	field := map[string]string{
+		"subscriber":   "subscriber",
+		"growth_7d":    "growth.day_7.subscriber",
+		"growth_30d":   "growth.day_30.subscriber",
+		"growth_365d":  "growth.day_365.subscriber",
+		"percent_7d":   "growth.day_7.percent",
+		"percent_30d":  "growth.day_30.percent",
+		"percent_365d": "growth.day_365.percent",
+	}[sort]
+
+	return bson.D{{Key: field, Value: order}, {Key: "vtuber_id", Value: 1}, {Key: "channel_type", Value: 1}}
+}
+
+func (m *Mongo) getChannelTypeFilter(types, excludeTypes []entity.ChannelType, match entity.MatchMode) bson.M {
 	values := make([]string, len(types))
 	for i, t := range types {
 		values[i] = string(t)
 	}
-	return m.getArrayFilter(values)
+
+	for _, t := range excludeTypes {
+		values = append(values, "-"+string(t))
+	}
+
+	return m.getArrayFilter(values, match)
 }
 
-func (m *Mongo) getArrayFilter(values []string) bson.M {
+func (m *Mongo) getActivityStatusFilter(statuses []entity.ActivityStatus) bson.M {
+	values := make([]string, len(statuses))
+	for i, s := range statuses {
+		values[i] = string(s)
+	}
+	return m.getArrayFilter(values, entity.MatchAny)
+}
+
+func (m *Mongo) getArrayFilter(values []string, match entity.MatchMode) bson.M {
 	var includeValues, excludeValues []string
 	for _, v := range values {
 		if v[0] == '-' {
@@ -306,9 +484,20 @@ func (m *Mongo) getArrayFilter(values []string) bson.M {
 		}
 	}
 
+	return m.getListFilter(m.strsToArray(includeValues), m.strsToArray(excludeValues), match)
+}
+
+// getListFilter to get multi-value filter of array field.
+// Any match mode needs at least 1 of the include values,
+// while all match mode (default) needs all of them.
+func (m *Mongo) getListFilter(includeValues, excludeValues bson.A, match entity.MatchMode) bson.M {
 	filter := bson.M{}
 	if len(includeValues) > 0 {
-		filter["$all"] = includeValues
+		if match == entity.MatchAny {
+			filter["$in"] = includeValues
+		} else {
+			filter["$all"] = includeValues
+		}
 	}
 
 	if len(excludeValues) > 0 {
@@ -318,6 +507,22 @@ func (m *Mongo) getArrayFilter(values []string) bson.M {
 	return filter
 }
 
+func (m *Mongo) strsToArray(strs []string) bson.A {
+	arr := make(bson.A, len(strs))
+	for i, str := range strs {
+		arr[i] = str
+	}
+	return arr
+}
+
+func (m *Mongo) int64sToArray(ints []int64) bson.A {
+	arr := make(bson.A, len(ints))
+	for i, n := range ints {
+		arr[i] = n
+	}
+	return arr
+}
+
 func (m *Mongo) getPipeline(stages ...bson.D) mongo.Pipeline {
 	var pipelines mongo.Pipeline
 	for _, stage := range stages {
@@ -365,6 +570,32 @@ func (m *Mongo) addMatch(matchStage bson.D, key string, value interface{}) bson.
 	return m.addStage("$match", matchStage, key, value)
 }
 
+// addAndMatch to add condition to $and of match stage,
+// so conditions of the same field don't override each other.
+func (m *Mongo) addAndMatch(matchStage bson.D, value bson.M) bson.D {
+	for i, stage := range matchStage {
+		if stage.Key != "$match" {
+			continue
+		}
+
+		matchValue, ok := stage.Value.(bson.M)
+		if !ok {
+			continue
+		}
+
+		and, _ := matchValue["$and"].([]bson.M)
+		matchValue["$and"] = append(and, value)
+
+		matchStage[i].Value = matchValue
+		return matchStage
+	}
+
+	return append(matchStage, bson.E{
+		Key:   "$match",
+		Value: bson.M{"$and": []bson.M{value}},
+	})
+}
+
 func (m *Mongo) addField(projStage bson.D, key string, value interface{}) bson.D {
 	return m.addStage("$addFields", projStage, key, value)
 }
@@ -467,3 +698,19 @@ func (m *Mongo) mergeDebutRetiredYearly(debut, retired []statusCountYearly) []en
 
 	return data
 }
+
+func (cg *channelGrowth) toEntity() entity.ChannelGrowth {
+	return entity.ChannelGrowth{
+		Day7:   entity.Growth(cg.Day7),
+		Day30:  entity.Growth(cg.Day30),
+		Day365: entity.Growth(cg.Day365),
+	}
+}
+
+func (m *Mongo) channelGrowthFromEntity(cg entity.ChannelGrowth) channelGrowth {
+	return channelGrowth{
+		Day7:   growth(cg.Day7),
+		Day30:  growth(cg.Day30),
+		Day365: growth(cg.Day365),
+	}
+}
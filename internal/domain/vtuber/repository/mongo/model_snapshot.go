@@ -0,0 +1,23 @@
+package mongo
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type vtuberSnapshot struct {
+	VtuberID  int64     `bson:"vtuber_id"`
+	Vtuber    *vtuber   `bson:"vtuber"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// MarshalBSON to override marshal function.
+func (vs *vtuberSnapshot) MarshalBSON() ([]byte, error) {
+	if vs.CreatedAt.IsZero() {
+		vs.CreatedAt = time.Now()
+	}
+
+	type vs2 vtuberSnapshot
+	return bson.Marshal((*vs2)(vs))
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	_errors "errors"
 	"net/http"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/rl404/fairy/errors/stack"
@@ -18,16 +20,22 @@ import (
 // Mongo contains functions for vtuber mongodb.
 type Mongo struct {
 	db            *mongo.Collection
+	snapshot      *mongo.Collection
 	oldActiveAge  time.Duration
 	oldRetiredAge time.Duration
+	hiatusAge     time.Duration
+	dormantAge    time.Duration
 }
 
 // New to create new vtuber mongodb.
-func New(db *mongo.Database, oldActiveAge, oldRetiredAge int) *Mongo {
+func New(db *mongo.Database, oldActiveAge, oldRetiredAge, hiatusAge, dormantAge int) *Mongo {
 	return &Mongo{
 		db:            db.Collection("vtuber"),
+		snapshot:      db.Collection("vtuber_snapshot"),
 		oldActiveAge:  time.Duration(oldActiveAge) * 24 * time.Hour,
 		oldRetiredAge: time.Duration(oldRetiredAge) * 24 * time.Hour,
+		hiatusAge:     time.Duration(hiatusAge) * 24 * time.Hour,
+		dormantAge:    time.Duration(dormantAge) * 24 * time.Hour,
 	}
 }
 
@@ -43,6 +51,53 @@ func (m *Mongo) GetByID(ctx context.Context, id int64) (*entity.Vtuber, int, err
 	return vtuber.toEntity(), http.StatusOK, nil
 }
 
+// GetByIDs to get data by ids.
+func (m *Mongo) GetByIDs(ctx context.Context, ids []int64) ([]entity.Vtuber, int, error) {
+	cursor, err := m.db.Find(ctx, bson.M{"id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	var vtubers []vtuber
+	if err := cursor.All(ctx, &vtubers); err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	res := make([]entity.Vtuber, len(vtubers))
+	for i, vtuber := range vtubers {
+		res[i] = *vtuber.toEntity()
+	}
+
+	return res, http.StatusOK, nil
+}
+
+// GetProjectionByID to get data by id with selected
+// fields and limited channel videos.
+func (m *Mongo) GetProjectionByID(ctx context.Context, id int64, projection entity.Projection) (*entity.Vtuber, int, error) {
+	matchStage := bson.D{{Key: "$match", Value: bson.M{"id": id}}}
+	projectStage := bson.D{}
+
+	if len(projection.Fields) > 0 {
+		projectStage = bson.D{{Key: "$project", Value: m.getFieldProjection(projection.Fields, nil)}}
+	}
+
+	cursor, err := m.db.Aggregate(ctx, m.getPipeline(matchStage, projectStage, m.getVideoStage(projection)))
+	if err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	var vtubers []vtuber
+	if err := cursor.All(ctx, &vtubers); err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	if len(vtubers) == 0 {
+		return nil, http.StatusNotFound, stack.Wrap(ctx, errors.ErrVtuberNotFound)
+	}
+
+	return vtubers[0].toEntity(), http.StatusOK, nil
+}
+
 // GetAllIDs to get all ids.
 func (m *Mongo) GetAllIDs(ctx context.Context) ([]int64, int, error) {
 	cursor, err := m.db.Find(ctx, bson.M{}, options.Find().SetProjection(bson.M{"id": 1}))
@@ -262,12 +317,85 @@ func (m *Mongo) GetAllForAgencyTree(ctx context.Context) ([]entity.Vtuber, int,
 	return res, http.StatusOK, nil
 }
 
+// GetAllForSearch to get all for search index.
+func (m *Mongo) GetAllForSearch(ctx context.Context) ([]entity.Vtuber, int, error) {
+	cursor, err := m.db.Find(ctx, bson.M{}, options.Find().SetProjection(bson.M{
+		"id":                    1,
+		"name":                  1,
+		"image":                 1,
+		"original_names":        1,
+		"nicknames":             1,
+		"subscriber":            1,
+		"character_designers":   1,
+		"character_2d_modelers": 1,
+		"character_3d_modelers": 1,
+	}))
+	if err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	var res []entity.Vtuber
+	for cursor.Next(ctx) {
+		var vtuber vtuber
+		if err := cursor.Decode(&vtuber); err != nil {
+			return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		}
+
+		res = append(res, entity.Vtuber{
+			ID:                  vtuber.ID,
+			Name:                vtuber.Name,
+			Image:               vtuber.Image,
+			OriginalNames:       vtuber.OriginalNames,
+			Nicknames:           vtuber.Nicknames,
+			Subscriber:          vtuber.Subscriber,
+			CharacterDesigners:  vtuber.CharacterDesigners,
+			Character2DModelers: vtuber.Character2DModelers,
+			Character3DModelers: vtuber.Character3DModelers,
+		})
+	}
+
+	return res, http.StatusOK, nil
+}
+
+// GetAllForSimilar to get all for similar vtuber.
+func (m *Mongo) GetAllForSimilar(ctx context.Context) ([]entity.Vtuber, int, error) {
+	cursor, err := m.db.Find(ctx, bson.M{}, options.Find().SetProjection(bson.M{
+		"id":                         1,
+		"name":                       1,
+		"image":                      1,
+		"debut_date":                 1,
+		"subscriber":                 1,
+		"agencies.id":                1,
+		"languages.id":               1,
+		"character_designers":        1,
+		"character_2d_modelers":      1,
+		"character_3d_modelers":      1,
+		"channels.videos.start_date": 1,
+	}))
+	if err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	var res []entity.Vtuber
+	for cursor.Next(ctx) {
+		var vtuber vtuber
+		if err := cursor.Decode(&vtuber); err != nil {
+			return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		}
+		res = append(res, *vtuber.toEntity())
+	}
+
+	return res, http.StatusOK, nil
+}
+
 // GetAll to get all data.
-func (m *Mongo) GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity.Vtuber, int, int, error) {
+func (m *Mongo) GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity.Vtuber, int, string, int, error) {
 	newFieldStage := bson.D{}
 	matchStage := bson.D{}
 	projectStage := bson.D{}
-	sortStage := bson.D{{Key: "$sort", Value: m.convertSort(data.Sort)}}
+	sort := m.convertSort(data.Sort)
+	cursorStage := bson.D{}
+	sortStage := bson.D{{Key: "$sort", Value: sort}}
 	skipStage := bson.D{{Key: "$skip", Value: (data.Page - 1) * data.Limit}}
 	limitStage := bson.D{}
 	countStage := bson.D{{Key: "$count", Value: "count"}}
@@ -280,6 +408,11 @@ func (m *Mongo) GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity
 			"debut_date":           1,
 			"retirement_date":      1,
 			"subscriber":           1,
+			"youtube_subscriber":   1,
+			"twitch_subscriber":    1,
+			"bilibili_subscriber":  1,
+			"niconico_subscriber":  1,
+			"total_subscriber":     1,
 			"monthly_subscriber":   1,
 			"video_count":          1,
 			"average_video_length": 1,
@@ -290,19 +423,45 @@ func (m *Mongo) GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity
 			"birthday":             1,
 			"emoji":                1,
 			"updated_at":           1,
-			"is_debut_date_null": bson.M{"$cond": bson.A{
-				bson.M{"$eq": bson.A{"$debut_date", nil}},
-				1, 0,
-			}},
+			"search_rank":          1,
+			"last_activity_date":   1,
+			"activity_status":      1,
+			"activity_rank":        1,
+			"is_debut_date_null":   m.getIsDebutDateNull(),
 		}}}
 	}
 
+	if len(data.Projection.Fields) > 0 {
+		projectStage = bson.D{{Key: "$project", Value: m.getFieldProjection(data.Projection.Fields, sort)}}
+	}
+
+	if strings.TrimPrefix(data.Sort, "-") == "activity_status" {
+		newFieldStage = m.addField(newFieldStage, "activity_rank", bson.M{"$indexOfArray": bson.A{bson.A{
+			entity.ActivityActive,
+			entity.ActivityHiatus,
+			entity.ActivityDormant,
+			entity.ActivityRetired,
+		}, "$activity_status"}})
+	}
+
+	if len(data.SearchIDs) > 0 {
+		newFieldStage = m.addField(newFieldStage, "search_rank", bson.M{"$indexOfArray": bson.A{data.SearchIDs, "$id"}})
+		matchStage = m.addMatch(matchStage, "id", bson.M{"$in": data.SearchIDs})
+	}
+
 	if data.Names != "" {
-		matchStage = m.addMatch(matchStage, "$or", []bson.M{
+		nameFilters := []bson.M{
 			{"name": bson.M{"$regex": data.Names, "$options": "i"}},
 			{"original_names": bson.M{"$regex": data.Names, "$options": "i"}},
 			{"nicknames": bson.M{"$regex": data.Names, "$options": "i"}},
-		})
+		}
+
+		// Prefix match so it can use the index.
+		if key := utils.NormalizeSearchText(data.Names); key != "" {
+			nameFilters = append(nameFilters, bson.M{"search_keys": bson.M{"$regex": "^" + regexp.QuoteMeta(key)}})
+		}
+
+		matchStage = m.addMatch(matchStage, "$or", nameFilters)
 	}
 
 	if data.Name != "" {
@@ -326,7 +485,7 @@ func (m *Mongo) GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity
 	}
 
 	if data.ExcludeActive && data.ExcludeRetired {
-		return nil, 0, http.StatusOK, nil
+		return nil, 0, "", http.StatusOK, nil
 	}
 
 	if data.DebutDay > 0 {
@@ -386,14 +545,26 @@ func (m *Mongo) GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity
 		matchStage = m.addMatch(matchStage, "character_designers", data.CharacterDesigner)
 	}
 
+	if len(data.CharacterDesigners) > 0 || len(data.ExcludeCharacterDesigners) > 0 {
+		matchStage = m.addAndMatch(matchStage, bson.M{"character_designers": m.getListFilter(m.strsToArray(data.CharacterDesigners), m.strsToArray(data.ExcludeCharacterDesigners), data.CharacterDesignerMatch)})
+	}
+
 	if data.Character2DModeler != "" {
 		matchStage = m.addMatch(matchStage, "character_2d_modelers", data.Character2DModeler)
 	}
 
+	if len(data.Character2DModelers) > 0 || len(data.ExcludeCharacter2DModelers) > 0 {
+		matchStage = m.addAndMatch(matchStage, bson.M{"character_2d_modelers": m.getListFilter(m.strsToArray(data.Character2DModelers), m.strsToArray(data.ExcludeCharacter2DModelers), data.Character2DModelerMatch)})
+	}
+
 	if data.Character3DModeler != "" {
 		matchStage = m.addMatch(matchStage, "character_3d_modelers", data.Character3DModeler)
 	}
 
+	if len(data.Character3DModelers) > 0 || len(data.ExcludeCharacter3DModelers) > 0 {
+		matchStage = m.addAndMatch(matchStage, bson.M{"character_3d_modelers": m.getListFilter(m.strsToArray(data.Character3DModelers), m.strsToArray(data.ExcludeCharacter3DModelers), data.Character3DModelerMatch)})
+	}
+
 	if data.InAgency != nil {
 		matchStage = m.addMatch(matchStage, "agencies.0", bson.M{"$exists": utils.PtrToBool(data.InAgency)})
 	}
@@ -406,12 +577,24 @@ func (m *Mongo) GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity
 		matchStage = m.addMatch(matchStage, "agencies.id", data.AgencyID)
 	}
 
+	if len(data.AgencyIDs) > 0 || len(data.ExcludeAgencyIDs) > 0 {
+		matchStage = m.addAndMatch(matchStage, bson.M{"agencies.id": m.getListFilter(m.int64sToArray(data.AgencyIDs), m.int64sToArray(data.ExcludeAgencyIDs), data.AgencyMatch)})
+	}
+
 	if data.LanguageID > 0 {
 		matchStage = m.addMatch(matchStage, "languages.id", data.LanguageID)
 	}
 
-	if len(data.ChannelTypes) > 0 {
-		matchStage = m.addMatch(matchStage, "channels.type", m.getChannelTypeFilter(data.ChannelTypes))
+	if len(data.LanguageIDs) > 0 || len(data.ExcludeLanguageIDs) > 0 {
+		matchStage = m.addAndMatch(matchStage, bson.M{"languages.id": m.getListFilter(m.int64sToArray(data.LanguageIDs), m.int64sToArray(data.ExcludeLanguageIDs), data.LanguageMatch)})
+	}
+
+	if len(data.ChannelTypes) > 0 || len(data.ExcludeChannelTypes) > 0 {
+		matchStage = m.addMatch(matchStage, "channels.type", m.getChannelTypeFilter(data.ChannelTypes, data.ExcludeChannelTypes, data.ChannelTypeMatch))
+	}
+
+	if len(data.ActivityStatuses) > 0 {
+		matchStage = m.addMatch(matchStage, "activity_status", m.getActivityStatusFilter(data.ActivityStatuses))
 	}
 
 	if data.BirthdayDay > 0 {
@@ -430,23 +613,23 @@ func (m *Mongo) GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity
 	}
 
 	if len(data.BloodTypes) > 0 {
-		matchStage = m.addMatch(matchStage, "blood_type", m.getArrayFilter(data.BloodTypes))
+		matchStage = m.addMatch(matchStage, "blood_type", m.getArrayFilter(data.BloodTypes, entity.MatchAll))
 	}
 
 	if len(data.Genders) > 0 {
-		matchStage = m.addMatch(matchStage, "gender", m.getArrayFilter(data.Genders))
+		matchStage = m.addMatch(matchStage, "gender", m.getArrayFilter(data.Genders, entity.MatchAll))
 	}
 
 	if len(data.Zodiacs) > 0 {
-		matchStage = m.addMatch(matchStage, "zodiac_sign", m.getArrayFilter(data.Zodiacs))
+		matchStage = m.addMatch(matchStage, "zodiac_sign", m.getArrayFilter(data.Zodiacs, entity.MatchAll))
 	}
 
 	if data.StartSubscriber > 0 {
-		matchStage = m.addMatch(matchStage, "subscriber", bson.M{"$gte": data.StartSubscriber})
+		matchStage = m.addMatch(matchStage, m.getSubscriberField(data.SubscriberPlatform), bson.M{"$gte": data.StartSubscriber})
 	}
 
 	if data.EndSubscriber > 0 {
-		matchStage = m.addMatch(matchStage, "subscriber", bson.M{"$lt": data.EndSubscriber})
+		matchStage = m.addMatch(matchStage, m.getSubscriberField(data.SubscriberPlatform), bson.M{"$lt": data.EndSubscriber})
 	}
 
 	if data.StartVideoCount > 0 {
@@ -457,40 +640,68 @@ func (m *Mongo) GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity
 		matchStage = m.addMatch(matchStage, "video_count", bson.M{"$lt": data.EndVideoCount})
 	}
 
+	if data.Filter != nil {
+		matchStage = m.addAndMatch(matchStage, m.getFilter(*data.Filter))
+	}
+
+	if data.Cursor != "" {
+		values, err := utils.DecodeCursor(data.Cursor, sort)
+		if err != nil {
+			return nil, 0, "", http.StatusBadRequest, stack.Wrap(ctx, err)
+		}
+
+		cursorStage = bson.D{{Key: "$match", Value: utils.GetCursorFilter(sort, values)}}
+		skipStage = bson.D{}
+	}
+
 	if data.Limit > 0 {
 		limitStage = append(limitStage, bson.E{Key: "$limit", Value: data.Limit})
 	}
 
-	cursor, err := m.db.Aggregate(ctx, m.getPipeline(newFieldStage, matchStage, projectStage, sortStage, skipStage, limitStage))
+	cursor, err := m.db.Aggregate(ctx, m.getPipeline(newFieldStage, matchStage, projectStage, cursorStage, sortStage, skipStage, limitStage, m.getVideoStage(data.Projection)))
 	if err != nil {
-		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
 	}
 
-	var vtubers []vtuber
-	if err := cursor.All(ctx, &vtubers); err != nil {
-		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	var raws []bson.Raw
+	if err := cursor.All(ctx, &raws); err != nil {
+		return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
 	}
 
-	res := make([]entity.Vtuber, len(vtubers))
-	for i, vtuber := range vtubers {
+	res := make([]entity.Vtuber, len(raws))
+	for i, raw := range raws {
+		var vtuber vtuber
+		if err := bson.Unmarshal(raw, &vtuber); err != nil {
+			return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		}
 		res[i] = *vtuber.toEntity()
 	}
 
+	var nextCursor string
+	if data.Limit > 0 && len(raws) == data.Limit {
+		nextCursor = utils.EncodeCursor(sort, raws[len(raws)-1])
+	}
+
+	// Total is only counted in page mode.
+	if data.Cursor != "" {
+		return res, 0, nextCursor, http.StatusOK, nil
+	}
+
 	cntCursor, err := m.db.Aggregate(ctx, m.getPipeline(newFieldStage, matchStage, countStage))
 	if err != nil {
-		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
 	}
 
 	var total []map[string]int64
 	if err := cntCursor.All(ctx, &total); err != nil {
-		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
 	}
 
 	if len(total) == 0 {
-		return res, 0, http.StatusOK, nil
+		return res, 0, nextCursor, http.StatusOK, nil
 	}
 
-	return res, int(total[0]["count"]), http.StatusOK, nil
+	return res, int(total[0]["count"]), nextCursor, http.StatusOK, nil
 }
 
 // GetCharacterDesigners to get character designers.
@@ -521,27 +732,32 @@ func (m *Mongo) GetCharacter3DModelers(ctx context.Context) ([]string, int, erro
 }
 
 // GetVideos to get videos.
-func (m *Mongo) GetVideos(ctx context.Context, data entity.GetVideosRequest) ([]entity.VtuberVideo, int, int, error) {
+func (m *Mongo) GetVideos(ctx context.Context, data entity.GetVideosRequest) ([]entity.VtuberVideo, int, string, int, error) {
 	unwindStage := bson.D{{Key: "$unwind", Value: "$channels"}}
 	unwindStage2 := bson.D{{Key: "$unwind", Value: "$channels.videos"}}
 	projectStage := bson.D{{Key: "$project", Value: bson.M{
-		"id":               "$id",
-		"vtuber_id":        "$id",
-		"vtuber_name":      "$name",
-		"vtuber_image":     "$image",
-		"channel_id":       "$channels.id",
-		"channel_name":     "$channels.name",
-		"channel_type":     "$channels.type",
-		"channel_url":      "$channels.url",
-		"video_id":         "$channels.videos.id",
-		"video_title":      "$channels.videos.title",
-		"video_url":        "$channels.videos.url",
-		"video_image":      "$channels.videos.image",
-		"video_start_date": "$channels.videos.start_date",
-		"video_end_date":   "$channels.videos.end_date",
+		"id":                       "$id",
+		"vtuber_id":                "$id",
+		"vtuber_name":              "$name",
+		"vtuber_image":             "$image",
+		"channel_id":               "$channels.id",
+		"channel_name":             "$channels.name",
+		"channel_type":             "$channels.type",
+		"channel_url":              "$channels.url",
+		"video_id":                 "$channels.videos.id",
+		"video_title":              "$channels.videos.title",
+		"video_url":                "$channels.videos.url",
+		"video_image":              "$channels.videos.image",
+		"video_start_date":         "$channels.videos.start_date",
+		"video_end_date":           "$channels.videos.end_date",
+		"video_scheduled_end_date": "$channels.videos.scheduled_end_date",
+		"video_category":           "$channels.videos.category",
+		"video_is_recurring":       "$channels.videos.is_recurring",
 	}}}
 	matchStage := bson.D{}
-	sortStage := bson.D{{Key: "$sort", Value: m.convertSort(data.Sort)}}
+	sort := append(m.convertSort(data.Sort), bson.E{Key: "video_id", Value: 1})
+	cursorStage := bson.D{}
+	sortStage := bson.D{{Key: "$sort", Value: sort}}
 	skipStage := bson.D{{Key: "$skip", Value: (data.Page - 1) * data.Limit}}
 	limitStage := bson.D{}
 	countStage := bson.D{{Key: "$count", Value: "count"}}
@@ -559,40 +775,140 @@ func (m *Mongo) GetVideos(ctx context.Context, data entity.GetVideosRequest) ([]
 		matchStage = m.addMatch(matchStage, "video_end_date", bson.M{key[*data.IsFinished]: nil})
 	}
 
+	if data.Cursor != "" {
+		values, err := utils.DecodeCursor(data.Cursor, sort)
+		if err != nil {
+			return nil, 0, "", http.StatusBadRequest, stack.Wrap(ctx, err)
+		}
+
+		cursorStage = bson.D{{Key: "$match", Value: utils.GetCursorFilter(sort, values)}}
+		skipStage = bson.D{}
+	}
+
 	if data.Limit > 0 {
 		limitStage = append(limitStage, bson.E{Key: "$limit", Value: data.Limit})
 	}
 
-	cursor, err := m.db.Aggregate(ctx, m.getPipeline(unwindStage, unwindStage2, projectStage, matchStage, sortStage, skipStage, limitStage))
+	cursor, err := m.db.Aggregate(ctx, m.getPipeline(unwindStage, unwindStage2, projectStage, matchStage, cursorStage, sortStage, skipStage, limitStage))
 	if err != nil {
-		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
 	}
 
-	var videos []vtuberVideo
-	if err := cursor.All(ctx, &videos); err != nil {
-		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	var raws []bson.Raw
+	if err := cursor.All(ctx, &raws); err != nil {
+		return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
 	}
 
-	res := make([]entity.VtuberVideo, len(videos))
-	for i, video := range videos {
+	res := make([]entity.VtuberVideo, len(raws))
+	for i, raw := range raws {
+		var video vtuberVideo
+		if err := bson.Unmarshal(raw, &video); err != nil {
+			return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		}
+
 		res[i] = entity.VtuberVideo{
-			VtuberID:       video.VtuberID,
-			VtuberName:     video.VtuberName,
-			VtuberImage:    video.VtuberImage,
-			ChannelID:      video.ChannelID,
-			ChannelName:    video.ChannelName,
-			ChannelType:    video.ChannelType,
-			ChannelURL:     video.ChannelURL,
-			VideoID:        video.VideoID,
-			VideoTitle:     video.VideoTitle,
-			VideoURL:       video.VideoURL,
-			VideoImage:     video.VideoImage,
-			VideoStartDate: video.VideoStartDate,
-			VideoEndDate:   video.VideoEndDate,
+			VtuberID:              video.VtuberID,
+			VtuberName:            video.VtuberName,
+			VtuberImage:           video.VtuberImage,
+			ChannelID:             video.ChannelID,
+			ChannelName:           video.ChannelName,
+			ChannelType:           video.ChannelType,
+			ChannelURL:            video.ChannelURL,
+			VideoID:               video.VideoID,
+			VideoTitle:            video.VideoTitle,
+			VideoURL:              video.VideoURL,
+			VideoImage:            video.VideoImage,
+			VideoStartDate:        video.VideoStartDate,
+			VideoEndDate:          video.VideoEndDate,
+			VideoScheduledEndDate: video.VideoScheduledEndDate,
+			VideoCategory:         video.VideoCategory,
+			VideoIsRecurring:      video.VideoIsRecurring,
 		}
 	}
 
+	var nextCursor string
+	if data.Limit > 0 && len(raws) == data.Limit {
+		nextCursor = utils.EncodeCursor(sort, raws[len(raws)-1])
+	}
+
+	// Total is only counted in page mode.
+	if data.Cursor != "" {
+		return res, 0, nextCursor, http.StatusOK, nil
+	}
+
 	cntCursor, err := m.db.Aggregate(ctx, m.getPipeline(unwindStage, unwindStage2, projectStage, matchStage, countStage))
+	if err != nil {
+		return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	var total []map[string]int64
+	if err := cntCursor.All(ctx, &total); err != nil {
+		return nil, 0, "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	if len(total) == 0 {
+		return res, 0, nextCursor, http.StatusOK, nil
+	}
+
+	return res, int(total[0]["count"]), nextCursor, http.StatusOK, nil
+}
+
+// GetFailingChannels to get channels failing to be fetched.
+func (m *Mongo) GetFailingChannels(ctx context.Context, data entity.GetFailingChannelsRequest) ([]entity.FailingChannel, int, int, error) {
+	unwindStage := bson.D{{Key: "$unwind", Value: "$channels"}}
+	projectStage := bson.D{{Key: "$project", Value: bson.M{
+		"vtuber_id":       "$id",
+		"vtuber_name":     "$name",
+		"channel_id":      "$channels.id",
+		"channel_name":    "$channels.name",
+		"channel_type":    "$channels.type",
+		"channel_url":     "$channels.url",
+		"last_success_at": "$channels.last_success_at",
+		"last_error":      "$channels.last_error",
+		"last_error_code": "$channels.last_error_code",
+		"fail_count":      "$channels.fail_count",
+	}}}
+	matchStage := bson.D{{Key: "$match", Value: bson.M{"fail_count": bson.M{"$gte": data.MinFailCount}}}}
+	sortStage := bson.D{{Key: "$sort", Value: bson.D{{Key: "fail_count", Value: -1}, {Key: "vtuber_id", Value: 1}}}}
+	skipStage := bson.D{{Key: "$skip", Value: (data.Page - 1) * data.Limit}}
+	limitStage := bson.D{}
+	countStage := bson.D{{Key: "$count", Value: "count"}}
+
+	if data.ChannelType != "" {
+		matchStage = m.addMatch(matchStage, "channel_type", data.ChannelType)
+	}
+
+	if data.Limit > 0 {
+		limitStage = append(limitStage, bson.E{Key: "$limit", Value: data.Limit})
+	}
+
+	cursor, err := m.db.Aggregate(ctx, m.getPipeline(unwindStage, projectStage, matchStage, sortStage, skipStage, limitStage))
+	if err != nil {
+		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	var channels []failingChannel
+	if err := cursor.All(ctx, &channels); err != nil {
+		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	res := make([]entity.FailingChannel, len(channels))
+	for i, c := range channels {
+		res[i] = entity.FailingChannel{
+			VtuberID:      c.VtuberID,
+			VtuberName:    c.VtuberName,
+			ChannelID:     c.ChannelID,
+			ChannelName:   c.ChannelName,
+			ChannelType:   c.ChannelType,
+			ChannelURL:    c.ChannelURL,
+			LastSuccessAt: c.LastSuccessAt,
+			LastError:     c.LastError,
+			LastErrorCode: c.LastErrorCode,
+			FailCount:     c.FailCount,
+		}
+	}
+
+	cntCursor, err := m.db.Aggregate(ctx, m.getPipeline(unwindStage, projectStage, matchStage, countStage))
 	if err != nil {
 		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
 	}
@@ -609,6 +925,18 @@ func (m *Mongo) GetVideos(ctx context.Context, data entity.GetVideosRequest) ([]
 	return res, int(total[0]["count"]), http.StatusOK, nil
 }
 
+// UpdateChannelLiveByID to update channel live state by id.
+func (m *Mongo) UpdateChannelLiveByID(ctx context.Context, id int64, channelType entity.ChannelType, channelID string, isLive bool) (int, error) {
+	if _, err := m.db.UpdateOne(ctx, bson.M{"id": id}, bson.M{"$set": bson.M{
+		"channels.$[c].is_live": isLive,
+	}}, options.UpdateOne().SetArrayFilters([]any{
+		bson.M{"c.type": channelType, "c.id": channelID},
+	})); err != nil {
+		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+	return http.StatusOK, nil
+}
+
 // UpdateOverriddenFieldByID to update overriden field by id.
 func (m *Mongo) UpdateOverriddenFieldByID(ctx context.Context, id int64, data entity.OverriddenField) (int, error) {
 	if _, err := m.db.UpdateOne(ctx, bson.M{"id": id}, bson.M{"$set": bson.M{
@@ -619,3 +947,43 @@ func (m *Mongo) UpdateOverriddenFieldByID(ctx context.Context, id int64, data en
 	}
 	return http.StatusOK, nil
 }
+
+// GetIDByChannelID to get vtuber id by channel id.
+func (m *Mongo) GetIDByChannelID(ctx context.Context, channelType entity.ChannelType, channelID string) (int64, int, error) {
+	var vtuber vtuber
+	if err := m.db.FindOne(ctx, bson.M{
+		"channels": bson.M{"$elemMatch": bson.M{"type": channelType, "id": channelID}},
+	}, options.FindOne().SetProjection(bson.M{"id": 1})).Decode(&vtuber); err != nil {
+		if _errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, http.StatusNotFound, stack.Wrap(ctx, err, errors.ErrVtuberNotFound)
+		}
+		return 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+	return vtuber.ID, http.StatusOK, nil
+}
+
+// GetActiveChannelIDs to get active vtuber channel ids.
+func (m *Mongo) GetActiveChannelIDs(ctx context.Context, channelType entity.ChannelType) ([]string, int, error) {
+	matchStage := bson.D{{Key: "$match", Value: bson.M{"retirement_date": bson.M{"$eq": nil}}}}
+	projectStage := bson.D{{Key: "$project", Value: bson.M{"channels.id": 1, "channels.type": 1}}}
+	unwindStage := bson.D{{Key: "$unwind", Value: "$channels"}}
+	matchStage2 := bson.D{{Key: "$match", Value: bson.M{"channels.type": channelType, "channels.id": bson.M{"$ne": ""}}}}
+	groupStage := bson.D{{Key: "$group", Value: bson.M{"_id": "$channels.id"}}}
+
+	cursor, err := m.db.Aggregate(ctx, m.getPipeline(matchStage, projectStage, unwindStage, matchStage2, groupStage))
+	if err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	var channels []map[string]string
+	if err := cursor.All(ctx, &channels); err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	ids := make([]string, len(channels))
+	for i, c := range channels {
+		ids[i] = c["_id"]
+	}
+
+	return ids, http.StatusOK, nil
+}
@@ -0,0 +1,58 @@
+package mongo
+
+import (
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// getFieldProjection to get projection of selected fields.
+// Sort fields are included so the sort still works.
+func (m *Mongo) getFieldProjection(fields []string, sort bson.D) bson.M {
+	projection := bson.M{"id": 1}
+	for _, f := range fields {
+		projection[f] = 1
+	}
+
+	for _, s := range sort {
+		if s.Key == "is_debut_date_null" {
+			projection[s.Key] = m.getIsDebutDateNull()
+			continue
+		}
+		projection[s.Key] = 1
+	}
+
+	return projection
+}
+
+func (m *Mongo) getIsDebutDateNull() bson.M {
+	return bson.M{"$cond": bson.A{
+		bson.M{"$eq": bson.A{"$debut_date", nil}},
+		1, 0,
+	}}
+}
+
+// getVideoStage to get stage to remove or limit channel videos.
+// Latest videos are sorted by start date.
+func (m *Mongo) getVideoStage(projection entity.Projection) bson.D {
+	switch projection.VideoMode {
+	case entity.VideoModeNone:
+		return bson.D{{Key: "$project", Value: bson.M{"channels.videos": 0}}}
+	case entity.VideoModeLatest:
+		return bson.D{{Key: "$addFields", Value: bson.M{"channels": bson.M{"$cond": bson.A{
+			bson.M{"$isArray": "$channels"},
+			bson.M{"$map": bson.M{
+				"input": "$channels",
+				"as":    "channel",
+				"in": bson.M{"$mergeObjects": bson.A{"$$channel", bson.M{
+					"videos": bson.M{"$slice": bson.A{
+						bson.M{"$sortArray": bson.M{"input": bson.M{"$ifNull": bson.A{"$$channel.videos", bson.A{}}}, "sortBy": bson.M{"start_date": -1}}},
+						projection.VideoLimit,
+					}},
+				}}},
+			}},
+			"$$REMOVE",
+		}}}}}
+	default:
+		return bson.D{}
+	}
+}
@@ -0,0 +1,96 @@
+package mongo
+
+import (
+	"context"
+	_errors "errors"
+	"net/http"
+	"time"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/errors"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// CreateSnapshot to create vtuber snapshot.
+// Channel videos are not included to keep the snapshot small.
+func (m *Mongo) CreateSnapshot(ctx context.Context, data entity.Vtuber) (int, error) {
+	vtuber := m.vtuberFromEntity(data)
+	for i := range vtuber.Channels {
+		vtuber.Channels[i].Videos = nil
+	}
+
+	if _, err := m.snapshot.InsertOne(ctx, &vtuberSnapshot{
+		VtuberID: data.ID,
+		Vtuber:   vtuber,
+	}); err != nil {
+		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	return http.StatusCreated, nil
+}
+
+// GetSnapshotByID to get latest vtuber snapshot before the time.
+func (m *Mongo) GetSnapshotByID(ctx context.Context, id int64, asOf time.Time) (*entity.Vtuber, int, error) {
+	filter := bson.M{
+		"vtuber_id":  id,
+		"created_at": bson.M{"$lte": bson.NewDateTimeFromTime(asOf)},
+	}
+
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	var snapshot vtuberSnapshot
+	if err := m.snapshot.FindOne(ctx, filter, opts).Decode(&snapshot); err != nil {
+		if _errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, http.StatusNotFound, stack.Wrap(ctx, err, errors.ErrVtuberSnapshotNotFound)
+		}
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	return snapshot.Vtuber.toEntity(), http.StatusOK, nil
+}
+
+// GetSnapshotsByAgencyID to get latest snapshot of agency members before the time.
+func (m *Mongo) GetSnapshotsByAgencyID(ctx context.Context, agencyID int64, asOf time.Time) ([]entity.Vtuber, int, error) {
+	// Only vtubers who have been in the agency
+	// before the time need to be checked.
+	matchStage := bson.D{{Key: "$match", Value: bson.M{
+		"vtuber.agencies.id": agencyID,
+		"created_at":         bson.M{"$lte": bson.NewDateTimeFromTime(asOf)},
+	}}}
+	groupStage := bson.D{{Key: "$group", Value: bson.M{"_id": "$vtuber_id"}}}
+	lookupStage := bson.D{{Key: "$lookup", Value: bson.M{
+		"from":         m.snapshot.Name(),
+		"localField":   "_id",
+		"foreignField": "vtuber_id",
+		"pipeline": bson.A{
+			bson.M{"$match": bson.M{"created_at": bson.M{"$lte": bson.NewDateTimeFromTime(asOf)}}},
+			bson.M{"$sort": bson.M{"created_at": -1}},
+			bson.M{"$limit": 1},
+		},
+		"as": "snapshot",
+	}}}
+	unwindStage := bson.D{{Key: "$unwind", Value: "$snapshot"}}
+	replaceStage := bson.D{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$snapshot"}}}
+	agencyStage := bson.D{{Key: "$match", Value: bson.M{"vtuber.agencies.id": agencyID}}}
+	nameStage := bson.D{{Key: "$sort", Value: bson.M{"vtuber.name": 1}}}
+
+	cursor, err := m.snapshot.Aggregate(ctx, m.getPipeline(matchStage, groupStage, lookupStage, unwindStage, replaceStage, agencyStage, nameStage), options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	var snapshots []vtuberSnapshot
+	if err := cursor.All(ctx, &snapshots); err != nil {
+		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	res := make([]entity.Vtuber, len(snapshots))
+	for i, snapshot := range snapshots {
+		res[i] = *snapshot.Vtuber.toEntity()
+	}
+
+	return res, http.StatusOK, nil
+}
@@ -42,13 +42,19 @@ func (m *Mongo) GetAverageActiveTime(ctx context.Context) (float64, int, error)
 // GetStatusCount to get status count.
 func (m *Mongo) GetStatusCount(ctx context.Context) (*entity.StatusCount, int, error) {
 	projectStage := bson.D{{Key: "$project", Value: bson.M{
-		"active":  bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$retirement_date", nil}}, 1, 0}},
-		"retired": bson.M{"$cond": bson.A{bson.M{"$ne": bson.A{"$retirement_date", nil}}, 1, 0}}}}}
+		"active":           bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$retirement_date", nil}}, 1, 0}},
+		"retired":          bson.M{"$cond": bson.A{bson.M{"$ne": bson.A{"$retirement_date", nil}}, 1, 0}},
+		"activity_active":  bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$activity_status", entity.ActivityActive}}, 1, 0}},
+		"activity_hiatus":  bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$activity_status", entity.ActivityHiatus}}, 1, 0}},
+		"activity_dormant": bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$activity_status", entity.ActivityDormant}}, 1, 0}}}}}
 
 	groupStage := bson.D{{Key: "$group", Value: bson.M{
-		"_id":     nil,
-		"active":  bson.M{"$sum": "$active"},
-		"retired": bson.M{"$sum": "$retired"},
+		"_id":              nil,
+		"active":           bson.M{"$sum": "$active"},
+		"retired":          bson.M{"$sum": "$retired"},
+		"activity_active":  bson.M{"$sum": "$activity_active"},
+		"activity_hiatus":  bson.M{"$sum": "$activity_hiatus"},
+		"activity_dormant": bson.M{"$sum": "$activity_dormant"},
 	}}}
 
 	cntCursor, err := m.db.Aggregate(ctx, m.getPipeline(projectStage, groupStage))
@@ -62,8 +68,11 @@ func (m *Mongo) GetStatusCount(ctx context.Context) (*entity.StatusCount, int, e
 	}
 
 	return &entity.StatusCount{
-		Active:  cnt[0]["active"],
-		Retired: cnt[0]["retired"],
+		Active:          cnt[0]["active"],
+		Retired:         cnt[0]["retired"],
+		ActivityActive:  cnt[0]["activity_active"],
+		ActivityHiatus:  cnt[0]["activity_hiatus"],
+		ActivityDormant: cnt[0]["activity_dormant"],
 	}, http.StatusOK, nil
 }
 
@@ -235,13 +244,13 @@ type subscriberCount struct {
 }
 
 // GetSubscriberCount to get subscriber count.
-func (m *Mongo) GetSubscriberCount(ctx context.Context, interval, max int) ([]entity.SubscriberCount, int, error) {
+func (m *Mongo) GetSubscriberCount(ctx context.Context, interval, max int, platform entity.SubscriberPlatform) ([]entity.SubscriberCount, int, error) {
 	boundaries := []int{}
 	for i := 0; i <= max; i += interval {
 		boundaries = append(boundaries, i)
 	}
 
-	projectStage := bson.D{{Key: "$project", Value: bson.M{"subscriber": bson.M{"$ifNull": bson.A{"$subscriber", 0}}}}}
+	projectStage := bson.D{{Key: "$project", Value: bson.M{"subscriber": bson.M{"$ifNull": bson.A{"$" + m.getSubscriberField(platform), 0}}}}}
 
 	bucketStage := bson.D{{Key: "$bucket", Value: bson.M{
 		"groupBy":    "$subscriber",
@@ -811,3 +820,92 @@ func (m *Mongo) GetZodiacCount(ctx context.Context) ([]entity.ZodiacCount, int,
 
 	return res, http.StatusOK, nil
 }
+
+// GetSubscriberGrowth to get channel subscriber growth.
+func (m *Mongo) GetSubscriberGrowth(ctx context.Context, data entity.GetSubscriberGrowthRequest) ([]entity.SubscriberGrowth, int, int, error) {
+	if data.ExcludeActive && data.ExcludeRetired {
+		return nil, 0, http.StatusOK, nil
+	}
+
+	vtuberMatchStage := bson.D{}
+	unwindStage := bson.D{{Key: "$unwind", Value: "$channels"}}
+	projectStage := bson.D{{Key: "$project", Value: bson.M{
+		"vtuber_id":    "$id",
+		"vtuber_name":  "$name",
+		"vtuber_image": "$image",
+		"channel_id":   "$channels.id",
+		"channel_name": "$channels.name",
+		"channel_type": "$channels.type",
+		"subscriber":   "$channels.subscriber",
+		"growth":       "$channels.growth",
+	}}}
+	channelMatchStage := bson.D{{Key: "$match", Value: bson.M{"channel_type": bson.M{"$ne": entity.ChannelOther}}}}
+	sortStage := bson.D{{Key: "$sort", Value: m.convertGrowthSort(data.Sort)}}
+	skipStage := bson.D{{Key: "$skip", Value: (data.Page - 1) * data.Limit}}
+	limitStage := bson.D{}
+	countStage := bson.D{{Key: "$count", Value: "count"}}
+
+	if data.ExcludeActive {
+		vtuberMatchStage = m.addMatch(vtuberMatchStage, "retirement_date", bson.M{"$ne": nil})
+	}
+
+	if data.ExcludeRetired {
+		vtuberMatchStage = m.addMatch(vtuberMatchStage, "retirement_date", bson.M{"$eq": nil})
+	}
+
+	if data.AgencyID > 0 {
+		vtuberMatchStage = m.addMatch(vtuberMatchStage, "agencies.id", data.AgencyID)
+	}
+
+	if data.LanguageID > 0 {
+		vtuberMatchStage = m.addMatch(vtuberMatchStage, "languages.id", data.LanguageID)
+	}
+
+	if data.ChannelType != "" {
+		channelMatchStage = m.addMatch(channelMatchStage, "channel_type", data.ChannelType)
+	}
+
+	if data.Limit > 0 {
+		limitStage = append(limitStage, bson.E{Key: "$limit", Value: data.Limit})
+	}
+
+	cursor, err := m.db.Aggregate(ctx, m.getPipeline(vtuberMatchStage, unwindStage, projectStage, channelMatchStage, sortStage, skipStage, limitStage))
+	if err != nil {
+		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	var growths []subscriberGrowth
+	if err := cursor.All(ctx, &growths); err != nil {
+		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	res := make([]entity.SubscriberGrowth, len(growths))
+	for i, g := range growths {
+		res[i] = entity.SubscriberGrowth{
+			VtuberID:    g.VtuberID,
+			VtuberName:  g.VtuberName,
+			VtuberImage: g.VtuberImage,
+			ChannelID:   g.ChannelID,
+			ChannelName: g.ChannelName,
+			ChannelType: g.ChannelType,
+			Subscriber:  g.Subscriber,
+			Growth:      g.Growth.toEntity(),
+		}
+	}
+
+	cntCursor, err := m.db.Aggregate(ctx, m.getPipeline(vtuberMatchStage, unwindStage, projectStage, channelMatchStage, countStage))
+	if err != nil {
+		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	var total []map[string]int64
+	if err := cntCursor.All(ctx, &total); err != nil {
+		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	if len(total) == 0 {
+		return res, 0, http.StatusOK, nil
+	}
+
+	return res, int(total[0]["count"]), http.StatusOK, nil
+}
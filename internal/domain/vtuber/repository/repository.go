@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
 )
@@ -9,21 +10,32 @@ import (
 // Repository contains functions for vtuber domain.
 type Repository interface {
 	GetByID(ctx context.Context, id int64) (*entity.Vtuber, int, error)
+	GetByIDs(ctx context.Context, ids []int64) ([]entity.Vtuber, int, error)
+	GetProjectionByID(ctx context.Context, id int64, projection entity.Projection) (*entity.Vtuber, int, error)
 	UpdateByID(ctx context.Context, id int64, data entity.Vtuber) (int, error)
+	UpdateChannelLiveByID(ctx context.Context, id int64, channelType entity.ChannelType, channelID string, isLive bool) (int, error)
 	UpdateOverriddenFieldByID(ctx context.Context, id int64, data entity.OverriddenField) (int, error)
 	DeleteByID(ctx context.Context, id int64) (int, error)
 	IsOld(ctx context.Context, id int64) (bool, int, error)
 	GetOldActiveIDs(ctx context.Context) ([]int64, int, error)
 	GetOldRetiredIDs(ctx context.Context) ([]int64, int, error)
-	GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity.Vtuber, int, int, error)
+	GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity.Vtuber, int, string, int, error)
 	GetAllIDs(ctx context.Context) ([]int64, int, error)
+	GetIDByChannelID(ctx context.Context, channelType entity.ChannelType, channelID string) (int64, int, error)
+	GetActiveChannelIDs(ctx context.Context, channelType entity.ChannelType) ([]string, int, error)
 	GetAllImages(ctx context.Context, shuffle bool, limit int) ([]entity.Vtuber, int, error)
 	GetAllForFamilyTree(ctx context.Context) ([]entity.Vtuber, int, error)
 	GetAllForAgencyTree(ctx context.Context) ([]entity.Vtuber, int, error)
+	GetAllForSearch(ctx context.Context) ([]entity.Vtuber, int, error)
+	GetAllForSimilar(ctx context.Context) ([]entity.Vtuber, int, error)
 	GetCharacterDesigners(ctx context.Context) ([]string, int, error)
 	GetCharacter2DModelers(ctx context.Context) ([]string, int, error)
 	GetCharacter3DModelers(ctx context.Context) ([]string, int, error)
-	GetVideos(ctx context.Context, data entity.GetVideosRequest) ([]entity.VtuberVideo, int, int, error)
+	GetVideos(ctx context.Context, data entity.GetVideosRequest) ([]entity.VtuberVideo, int, string, int, error)
+	GetFailingChannels(ctx context.Context, data entity.GetFailingChannelsRequest) ([]entity.FailingChannel, int, int, error)
+	CreateSnapshot(ctx context.Context, data entity.Vtuber) (int, error)
+	GetSnapshotByID(ctx context.Context, id int64, asOf time.Time) (*entity.Vtuber, int, error)
+	GetSnapshotsByAgencyID(ctx context.Context, agencyID int64, asOf time.Time) ([]entity.Vtuber, int, error)
 	GetCount(ctx context.Context) (int, int, error)
 	GetAverageActiveTime(ctx context.Context) (float64, int, error)
 	GetStatusCount(ctx context.Context) (*entity.StatusCount, int, error)
@@ -31,7 +43,7 @@ type Repository interface {
 	GetDebutRetireCountYearly(ctx context.Context) ([]entity.DebutRetireCount, int, error)
 	GetModelCount(ctx context.Context) (*entity.ModelCount, int, error)
 	GetInAgencyCount(ctx context.Context) (*entity.InAgencyCount, int, error)
-	GetSubscriberCount(ctx context.Context, interval, max int) ([]entity.SubscriberCount, int, error)
+	GetSubscriberCount(ctx context.Context, interval, max int, platform entity.SubscriberPlatform) ([]entity.SubscriberCount, int, error)
 	GetDesignerCount(ctx context.Context, top int) ([]entity.DesignerCount, int, error)
 	Get2DModelerCount(ctx context.Context, top int) ([]entity.DesignerCount, int, error)
 	Get3DModelerCount(ctx context.Context, top int) ([]entity.DesignerCount, int, error)
@@ -48,4 +60,5 @@ type Repository interface {
 	GetChannelTypeCount(ctx context.Context) ([]entity.ChannelTypeCount, int, error)
 	GetGenderCount(ctx context.Context) ([]entity.GenderCount, int, error)
 	GetZodiacCount(ctx context.Context) ([]entity.ZodiacCount, int, error)
+	GetSubscriberGrowth(ctx context.Context, data entity.GetSubscriberGrowthRequest) ([]entity.SubscriberGrowth, int, int, error)
 }
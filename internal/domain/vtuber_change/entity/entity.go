@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+// Source is change source.
+type Source string
+
+// Available change sources.
+const (
+	SourceWiki     Source = "WIKI"
+	SourceOverride Source = "OVERRIDE"
+	SourceAdmin    Source = "ADMIN"
+)
+
+// Change is entity for vtuber field change.
+type Change struct {
+	VtuberID   int64
+	VtuberName string
+	Field      string
+	Source     Source
+	OldValue   string
+	NewValue   string
+	CreatedAt  time.Time
+}
+
+// GetAllRequest is get all request model.
+type GetAllRequest struct {
+	VtuberID int64
+	Field    string
+	Source   Source
+	Page     int
+	Limit    int
+}
@@ -0,0 +1,17 @@
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// CreateIndexes to create vtuber-change collection indexes.
+func CreateIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("vtuber_changes").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "vtuber_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "created_at", Value: -1}}},
+	})
+	return err
+}
@@ -0,0 +1,40 @@
+package mongo
+
+import (
+	"time"
+
+	"github.com/rl404/shimakaze/internal/domain/vtuber_change/entity"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type change struct {
+	VtuberID   int64         `bson:"vtuber_id"`
+	VtuberName string        `bson:"vtuber_name"`
+	Field      string        `bson:"field"`
+	Source     entity.Source `bson:"source"`
+	OldValue   string        `bson:"old_value"`
+	NewValue   string        `bson:"new_value"`
+	CreatedAt  time.Time     `bson:"created_at"`
+}
+
+// MarshalBSON to override marshal function.
+func (c *change) MarshalBSON() ([]byte, error) {
+	if c.CreatedAt.IsZero() {
+		c.CreatedAt = time.Now()
+	}
+
+	type c2 change
+	return bson.Marshal((*c2)(c))
+}
+
+func (c *change) toEntity() entity.Change {
+	return entity.Change{
+		VtuberID:   c.VtuberID,
+		VtuberName: c.VtuberName,
+		Field:      c.Field,
+		Source:     c.Source,
+		OldValue:   c.OldValue,
+		NewValue:   c.NewValue,
+		CreatedAt:  c.CreatedAt,
+	}
+}
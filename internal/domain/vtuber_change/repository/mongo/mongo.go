@@ -0,0 +1,95 @@
+package mongo
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/vtuber_change/entity"
+	"github.com/rl404/shimakaze/internal/errors"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Mongo contains functions for vtuber-change mongodb.
+type Mongo struct {
+	db *mongo.Collection
+}
+
+// New to create new vtuber-change mongodb.
+func New(db *mongo.Database) *Mongo {
+	return &Mongo{
+		db: db.Collection("vtuber_changes"),
+	}
+}
+
+// Create to create vtuber changes.
+func (m *Mongo) Create(ctx context.Context, data []entity.Change) (int, error) {
+	if len(data) == 0 {
+		return http.StatusOK, nil
+	}
+
+	changes := make([]any, len(data))
+	for i, d := range data {
+		changes[i] = &change{
+			VtuberID:   d.VtuberID,
+			VtuberName: d.VtuberName,
+			Field:      d.Field,
+			Source:     d.Source,
+			OldValue:   d.OldValue,
+			NewValue:   d.NewValue,
+			CreatedAt:  d.CreatedAt,
+		}
+	}
+
+	if _, err := m.db.InsertMany(ctx, changes); err != nil {
+		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	return http.StatusCreated, nil
+}
+
+// GetAll to get vtuber changes.
+func (m *Mongo) GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity.Change, int, int, error) {
+	query := bson.M{}
+	opt := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).SetSkip(int64((data.Page - 1) * data.Limit)).SetLimit(int64(data.Limit))
+
+	if data.VtuberID > 0 {
+		query["vtuber_id"] = data.VtuberID
+	}
+
+	if data.Field != "" {
+		query["field"] = data.Field
+	}
+
+	if data.Source != "" {
+		query["source"] = data.Source
+	}
+
+	if data.Limit < 0 {
+		opt.SetLimit(0)
+	}
+
+	c, err := m.db.Find(ctx, query, opt)
+	if err != nil {
+		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+	defer c.Close(ctx)
+
+	var changes []entity.Change
+	for c.Next(ctx) {
+		var change change
+		if err := c.Decode(&change); err != nil {
+			return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+		}
+		changes = append(changes, change.toEntity())
+	}
+
+	total, err := m.db.CountDocuments(ctx, query, options.Count())
+	if err != nil {
+		return nil, 0, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalDB)
+	}
+
+	return changes, int(total), http.StatusOK, nil
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/rl404/shimakaze/internal/domain/vtuber_change/entity"
+)
+
+// Repository contains functions for vtuber-change domain.
+type Repository interface {
+	Create(ctx context.Context, data []entity.Change) (int, error)
+	GetAll(ctx context.Context, data entity.GetAllRequest) ([]entity.Change, int, int, error)
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// Notification is entity for websub feed notification.
+type Notification struct {
+	ChannelID string
+	VideoID   string
+	Title     string
+	Published *time.Time
+	Updated   *time.Time
+	Deleted   bool
+}
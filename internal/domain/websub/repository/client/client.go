@@ -0,0 +1,33 @@
+package client
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// Client contains functions for youtube websub client.
+type Client struct {
+	hub          string
+	topic        string
+	callback     string
+	secret       string
+	leaseSeconds int
+	http         *http.Client
+}
+
+// New to create new youtube websub client.
+func New(hub, callback, secret string, leaseSeconds int) *Client {
+	return &Client{
+		hub:          hub,
+		topic:        "https://www.youtube.com/xml/feeds/videos.xml",
+		callback:     callback,
+		secret:       secret,
+		leaseSeconds: leaseSeconds,
+		http: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: newrelic.NewRoundTripper(http.DefaultTransport),
+		},
+	}
+}
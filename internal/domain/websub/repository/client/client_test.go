@@ -0,0 +1,197 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns:yt="http://www.youtube.com/xml/schemas/2015" xmlns="http://www.w3.org/2005/Atom">
+  <entry>
+    <yt:videoId>video-1</yt:videoId>
+    <yt:channelId>channel-1</yt:channelId>
+    <title>Video 1</title>
+    <published>2024-01-02T03:04:05+00:00</published>
+    <updated>2024-01-02T03:04:06.123456+00:00</updated>
+  </entry>
+</feed>`
+
+func sign(newHash func() hash.Hash, algo, secret, body string) string {
+	mac := hmac.New(newHash, []byte(secret))
+	mac.Write([]byte(body))
+	return algo + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// stubHub is a minimal websub hub. It verifies the subscription
+// intent to the callback, then pushes a signed notification.
+type stubHub struct {
+	t         *testing.T
+	verified  chan string
+	delivered chan int
+}
+
+func (h *stubHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	callback := r.PostForm.Get("hub.callback")
+	topic := r.PostForm.Get("hub.topic")
+	secret := r.PostForm.Get("hub.secret")
+
+	if r.PostForm.Get("hub.mode") != "subscribe" || callback == "" || topic == "" || secret == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+
+	go func() {
+		q := url.Values{}
+		q.Set("hub.mode", "subscribe")
+		q.Set("hub.topic", topic)
+		q.Set("hub.challenge", "challenge-123")
+		q.Set("hub.lease_seconds", r.PostForm.Get("hub.lease_seconds"))
+
+		resp, err := http.Get(callback + "?" + q.Encode())
+		if err != nil {
+			h.verified <- ""
+			return
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		h.verified <- string(body)
+
+		req, _ := http.NewRequest(http.MethodPost, callback, strings.NewReader(testFeed))
+		req.Header.Set("X-Hub-Signature", sign(sha1.New, "sha1", secret, testFeed))
+		resp, err = http.DefaultClient.Do(req)
+		if err != nil {
+			h.delivered <- 0
+			return
+		}
+		resp.Body.Close()
+		h.delivered <- resp.StatusCode
+	}()
+}
+
+func TestSubscribeVerifyNotify(t *testing.T) {
+	hub := &stubHub{t: t, verified: make(chan string, 1), delivered: make(chan int, 1)}
+	hubServer := httptest.NewServer(hub)
+	defer hubServer.Close()
+
+	notified := make(chan string, 1)
+
+	var c *Client
+	callbackServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			channelID, _, err := c.GetChannelIDByTopic(r.Context(), r.URL.Query().Get("hub.topic"))
+			if err != nil || channelID != "channel-1" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			fmt.Fprint(w, r.URL.Query().Get("hub.challenge"))
+
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			notifications, code, err := c.ParseNotification(r.Context(), body, r.Header.Get("X-Hub-Signature"))
+			if err != nil {
+				w.WriteHeader(code)
+				return
+			}
+			for _, n := range notifications {
+				notified <- n.ChannelID + "/" + n.VideoID
+			}
+		}
+	}))
+	defer callbackServer.Close()
+
+	c = New(hubServer.URL, callbackServer.URL, "secret-123", 3600)
+
+	code, err := c.Subscribe(context.Background(), "channel-1")
+	if err != nil {
+		t.Fatalf("subscribe error: %v", err)
+	}
+
+	if code != http.StatusAccepted {
+		t.Fatalf("subscribe code = %d, want %d", code, http.StatusAccepted)
+	}
+
+	select {
+	case challenge := <-hub.verified:
+		if challenge != "challenge-123" {
+			t.Fatalf("verify challenge = %q, want %q", challenge, "challenge-123")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("verify timeout")
+	}
+
+	select {
+	case n := <-notified:
+		if n != "channel-1/video-1" {
+			t.Fatalf("notification = %q, want %q", n, "channel-1/video-1")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("notify timeout")
+	}
+
+	if code := <-hub.delivered; code != http.StatusOK {
+		t.Fatalf("deliver code = %d, want %d", code, http.StatusOK)
+	}
+}
+
+func TestParseNotification(t *testing.T) {
+	c := New("", "", "secret-123", 0)
+
+	tests := []struct {
+		name      string
+		body      string
+		signature string
+		wantCode  int
+		wantLen   int
+	}{
+		{name: "sha1", body: testFeed, signature: sign(sha1.New, "sha1", "secret-123", testFeed), wantCode: http.StatusOK, wantLen: 1},
+		{name: "sha256", body: testFeed, signature: sign(sha256.New, "sha256", "secret-123", testFeed), wantCode: http.StatusOK, wantLen: 1},
+		{name: "unsigned", body: testFeed, signature: "", wantCode: http.StatusUnauthorized},
+		{name: "wrong-secret", body: testFeed, signature: sign(sha1.New, "sha1", "other", testFeed), wantCode: http.StatusUnauthorized},
+		{name: "tampered-body", body: testFeed + " ", signature: sign(sha1.New, "sha1", "secret-123", testFeed), wantCode: http.StatusUnauthorized},
+		{name: "unknown-algo", body: testFeed, signature: "md5=abcd", wantCode: http.StatusUnauthorized},
+		{name: "no-algo", body: testFeed, signature: "abcd", wantCode: http.StatusUnauthorized},
+		{name: "invalid-hex", body: testFeed, signature: "sha1=zz", wantCode: http.StatusUnauthorized},
+		{name: "invalid-xml", body: "<feed>", signature: sign(sha1.New, "sha1", "secret-123", "<feed>"), wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, code, _ := c.ParseNotification(context.Background(), []byte(tt.body), tt.signature)
+			if code != tt.wantCode {
+				t.Fatalf("code = %d, want %d", code, tt.wantCode)
+			}
+			if len(res) != tt.wantLen {
+				t.Fatalf("len = %d, want %d", len(res), tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestParseNotificationWithoutSecret(t *testing.T) {
+	c := New("", "", "", 0)
+
+	_, code, _ := c.ParseNotification(context.Background(), []byte(testFeed), "")
+	if code != http.StatusUnauthorized {
+		t.Fatalf("code = %d, want %d", code, http.StatusUnauthorized)
+	}
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/xml"
+	"hash"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/websub/entity"
+	"github.com/rl404/shimakaze/internal/errors"
+)
+
+type feed struct {
+	Entries []struct {
+		VideoID   string `xml:"videoId"`
+		ChannelID string `xml:"channelId"`
+		Title     string `xml:"title"`
+		Published string `xml:"published"`
+		Updated   string `xml:"updated"`
+	} `xml:"entry"`
+	DeletedEntries []struct {
+		Ref string `xml:"ref,attr"`
+		By  struct {
+			URI string `xml:"uri"`
+		} `xml:"by"`
+	} `xml:"deleted-entry"`
+}
+
+var signatureHashes = map[string]func() hash.Hash{
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha384": sha512.New384,
+	"sha512": sha512.New,
+}
+
+// ParseNotification to verify and parse websub feed notification.
+func (c *Client) ParseNotification(ctx context.Context, body []byte, signature string) ([]entity.Notification, int, error) {
+	if !c.isValidSignature(body, signature) {
+		return nil, http.StatusUnauthorized, stack.Wrap(ctx, errors.ErrInvalidWebSubSignature)
+	}
+
+	var f feed
+	if err := xml.Unmarshal(body, &f); err != nil {
+		return nil, http.StatusBadRequest, stack.Wrap(ctx, err, errors.ErrInvalidRequestFormat)
+	}
+
+	res := make([]entity.Notification, 0, len(f.Entries)+len(f.DeletedEntries))
+	for _, e := range f.Entries {
+		res = append(res, entity.Notification{
+			ChannelID: e.ChannelID,
+			VideoID:   e.VideoID,
+			Title:     e.Title,
+			Published: c.parseTime(e.Published),
+			Updated:   c.parseTime(e.Updated),
+		})
+	}
+
+	for _, e := range f.DeletedEntries {
+		res = append(res, entity.Notification{
+			ChannelID: e.By.URI[strings.LastIndex(e.By.URI, "/")+1:],
+			VideoID:   strings.TrimPrefix(e.Ref, "yt:video:"),
+			Deleted:   true,
+		})
+	}
+
+	return res, http.StatusOK, nil
+}
+
+// isValidSignature to verify the hub signature. Unsigned
+// body is rejected since anyone can push to the callback.
+func (c *Client) isValidSignature(body []byte, signature string) bool {
+	if c.secret == "" || signature == "" {
+		return false
+	}
+
+	algo, sig, ok := strings.Cut(signature, "=")
+	if !ok {
+		return false
+	}
+
+	newHash, ok := signatureHashes[algo]
+	if !ok {
+		return false
+	}
+
+	expected, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(newHash, []byte(c.secret))
+	mac.Write(body)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+func (c *Client) parseTime(str string) *time.Time {
+	t, err := time.Parse(time.RFC3339Nano, str)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	_errors "errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/errors"
+)
+
+// Subscribe to subscribe channel feed to websub hub.
+func (c *Client) Subscribe(ctx context.Context, channelID string) (int, error) {
+	form := url.Values{}
+	form.Add("hub.callback", c.callback)
+	form.Add("hub.topic", fmt.Sprintf("%s?channel_id=%s", c.topic, channelID))
+	form.Add("hub.mode", "subscribe")
+	form.Add("hub.verify", "async")
+	form.Add("hub.lease_seconds", strconv.Itoa(c.leaseSeconds))
+	form.Add("hub.secret", c.secret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.hub, strings.NewReader(form.Encode()))
+	if err != nil {
+		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusNoContent {
+		return resp.StatusCode, stack.Wrap(ctx, _errors.New(http.StatusText(resp.StatusCode)))
+	}
+
+	return http.StatusAccepted, nil
+}
+
+// GetChannelIDByTopic to get channel id from websub topic.
+func (c *Client) GetChannelIDByTopic(ctx context.Context, topic string) (string, int, error) {
+	u, err := url.Parse(topic)
+	if err != nil {
+		return "", http.StatusBadRequest, stack.Wrap(ctx, err, errors.ErrInvalidWebSubTopic)
+	}
+
+	if !strings.HasPrefix(topic, c.topic) {
+		return "", http.StatusBadRequest, stack.Wrap(ctx, errors.ErrInvalidWebSubTopic)
+	}
+
+	channelID := u.Query().Get("channel_id")
+	if channelID == "" {
+		return "", http.StatusBadRequest, stack.Wrap(ctx, errors.ErrInvalidWebSubTopic)
+	}
+
+	return channelID, http.StatusOK, nil
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/rl404/shimakaze/internal/domain/websub/entity"
+)
+
+// Repository contains functions for websub domain.
+type Repository interface {
+	Subscribe(ctx context.Context, channelID string) (int, error)
+	GetChannelIDByTopic(ctx context.Context, topic string) (string, int, error)
+	ParseNotification(ctx context.Context, body []byte, signature string) ([]entity.Notification, int, error)
+}
@@ -0,0 +1,108 @@
+package breaker
+
+import (
+	"context"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/wikia/entity"
+	"github.com/rl404/shimakaze/internal/domain/wikia/repository"
+	_breaker "github.com/rl404/shimakaze/pkg/breaker"
+)
+
+// Breaker contains functions for wikia circuit breaker.
+type Breaker struct {
+	breaker *_breaker.Breaker
+	repo    repository.Repository
+}
+
+// New to create new wikia circuit breaker.
+func New(breaker *_breaker.Breaker, repo repository.Repository) *Breaker {
+	return &Breaker{
+		breaker: breaker,
+		repo:    repo,
+	}
+}
+
+// GetPages to get pages.
+func (b *Breaker) GetPages(ctx context.Context, apLimit int, apContinue string) (data []entity.Page, next string, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetPages", func() (int, error) {
+		data, next, code, err = b.repo.GetPages(ctx, apLimit, apContinue)
+		return code, err
+	})
+	if err != nil {
+		return nil, "", code, stack.Wrap(ctx, err)
+	}
+	return data, next, code, nil
+}
+
+// GetPageByID to get page by id.
+func (b *Breaker) GetPageByID(ctx context.Context, id int64) (data *entity.Page, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetPageByID", func() (int, error) {
+		data, code, err = b.repo.GetPageByID(ctx, id)
+		return code, err
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
+
+// GetPageImageByID to get page image by id.
+func (b *Breaker) GetPageImageByID(ctx context.Context, id int64) (data *entity.PageImage, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetPageImageByID", func() (int, error) {
+		data, code, err = b.repo.GetPageImageByID(ctx, id)
+		return code, err
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
+
+// GetCategoryMembers to get category members.
+func (b *Breaker) GetCategoryMembers(ctx context.Context, cmTitle string, cmLimit int, cmContinue string, isPage bool) (data []entity.CategoryMember, next string, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetCategoryMembers", func() (int, error) {
+		data, next, code, err = b.repo.GetCategoryMembers(ctx, cmTitle, cmLimit, cmContinue, isPage)
+		return code, err
+	})
+	if err != nil {
+		return nil, "", code, stack.Wrap(ctx, err)
+	}
+	return data, next, code, nil
+}
+
+// GetImageInfo to get image info.
+func (b *Breaker) GetImageInfo(ctx context.Context, imageName string) (data string, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetImageInfo", func() (int, error) {
+		data, code, err = b.repo.GetImageInfo(ctx, imageName)
+		return code, err
+	})
+	if err != nil {
+		return "", code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
+
+// GetPageCategories to get page categories.
+func (b *Breaker) GetPageCategories(ctx context.Context, id int64, clLimit int, clContinue string) (data []entity.PageCategory, next string, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetPageCategories", func() (int, error) {
+		data, next, code, err = b.repo.GetPageCategories(ctx, id, clLimit, clContinue)
+		return code, err
+	})
+	if err != nil {
+		return nil, "", code, stack.Wrap(ctx, err)
+	}
+	return data, next, code, nil
+}
+
+// GetImage to get image.
+func (b *Breaker) GetImage(ctx context.Context, path string) (data []byte, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetImage", func() (int, error) {
+		data, code, err = b.repo.GetImage(ctx, path)
+		return code, err
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
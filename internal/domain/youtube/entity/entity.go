@@ -8,6 +8,8 @@ type Channel struct {
 	Name       string
 	Image      string
 	Subscriber int
+	ViewCount  int
+	VideoCount int
 }
 
 // Video is entity for video.
@@ -0,0 +1,72 @@
+package breaker
+
+import (
+	"context"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/youtube/entity"
+	"github.com/rl404/shimakaze/internal/domain/youtube/repository"
+	_breaker "github.com/rl404/shimakaze/pkg/breaker"
+)
+
+// Breaker contains functions for youtube circuit breaker.
+type Breaker struct {
+	breaker *_breaker.Breaker
+	repo    repository.Repository
+}
+
+// New to create new youtube circuit breaker.
+func New(breaker *_breaker.Breaker, repo repository.Repository) *Breaker {
+	return &Breaker{
+		breaker: breaker,
+		repo:    repo,
+	}
+}
+
+// GetChannelIDByURL to get channel id by url.
+func (b *Breaker) GetChannelIDByURL(ctx context.Context, url string) (data string, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetChannelIDByURL", func() (int, error) {
+		data, code, err = b.repo.GetChannelIDByURL(ctx, url)
+		return code, err
+	})
+	if err != nil {
+		return "", code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
+
+// GetChannelByID to get channel by id.
+func (b *Breaker) GetChannelByID(ctx context.Context, id string) (data *entity.Channel, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetChannelByID", func() (int, error) {
+		data, code, err = b.repo.GetChannelByID(ctx, id)
+		return code, err
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
+
+// GetVideoIDsByChannelID to get video ids by channel id.
+func (b *Breaker) GetVideoIDsByChannelID(ctx context.Context, channelID string) (data []string, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetVideoIDsByChannelID", func() (int, error) {
+		data, code, err = b.repo.GetVideoIDsByChannelID(ctx, channelID)
+		return code, err
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
+
+// GetVideosByIDs to get videos by ids.
+func (b *Breaker) GetVideosByIDs(ctx context.Context, ids []string) (data []entity.Video, code int, err error) {
+	code, err = b.breaker.Do(ctx, "GetVideosByIDs", func() (int, error) {
+		data, code, err = b.repo.GetVideosByIDs(ctx, ids)
+		return code, err
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+	return data, code, nil
+}
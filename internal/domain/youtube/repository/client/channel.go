@@ -27,6 +27,8 @@ type getChannelsByIDsResponse struct {
 		} `json:"snippet"`
 		Statistics struct {
 			SubscriberCount string `json:"subscriberCount"`
+			ViewCount       string `json:"viewCount"`
+			VideoCount      string `json:"videoCount"`
 		} `json:"statistics"`
 	} `json:"items"`
 }
@@ -54,6 +56,8 @@ func (c *Client) GetChannelByID(ctx context.Context, id string) (*entity.Channel
 		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
 	}
 
+	c.limiter.Take()
+
 	resp, err := c.http.Do(req)
 	if err != nil {
 		return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
@@ -79,7 +83,9 @@ func (c *Client) GetChannelByID(ctx context.Context, id string) (*entity.Channel
 			ID:         channel.ID,
 			Name:       channel.Snippet.Title,
 			Image:      c.getChannelImage(channel.Snippet.Thumbnails),
-			Subscriber: c.getSubscriber(channel.Statistics.SubscriberCount),
+			Subscriber: c.getCount(channel.Statistics.SubscriberCount),
+			ViewCount:  c.getCount(channel.Statistics.ViewCount),
+			VideoCount: c.getCount(channel.Statistics.VideoCount),
 		}, http.StatusOK, nil
 	}
 
@@ -93,7 +99,7 @@ func (c *Client) getChannelImage(thumbnails channelThumbnails) string {
 	return thumbnails.Default.URL
 }
 
-func (c *Client) getSubscriber(str string) int {
-	subs, _ := strconv.Atoi(str)
-	return subs
+func (c *Client) getCount(str string) int {
+	cnt, _ := strconv.Atoi(str)
+	return cnt
 }
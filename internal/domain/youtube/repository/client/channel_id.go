@@ -18,6 +18,8 @@ func (c *Client) GetChannelIDByURL(ctx context.Context, url string) (string, int
 		return "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
 	}
 
+	c.limiter.Take()
+
 	resp, err := c.http.Do(req)
 	if err != nil {
 		return "", http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
@@ -6,17 +6,20 @@ import (
 	"time"
 
 	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/rl404/fairy/limit"
+	"github.com/rl404/fairy/limit/mutex"
 )
 
 // Client contains functions for youtube api client.
 type Client struct {
-	host   string
-	http   *http.Client
-	maxAge time.Time
+	host    string
+	http    *http.Client
+	maxAge  time.Time
+	limiter limit.Limiter
 }
 
 // New to create new youtube client.
-func New(keys []string, maxAge int) *Client {
+func New(keys []string, maxAge, rateLimit int) *Client {
 	return &Client{
 		host: "https://www.googleapis.com/youtube/v3",
 		http: &http.Client{
@@ -26,7 +29,8 @@ func New(keys []string, maxAge int) *Client {
 				keys:       keys,
 			}),
 		},
-		maxAge: time.Now().Add(time.Duration(maxAge*-24) * time.Hour),
+		maxAge:  time.Now().Add(time.Duration(maxAge*-24) * time.Hour),
+		limiter: mutex.New(rateLimit, time.Second),
 	}
 }
 
@@ -50,6 +50,8 @@ func (c *Client) GetVideoIDsByChannelID(ctx context.Context, channelID string) (
 			return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
 		}
 
+		c.limiter.Take()
+
 		resp, err := c.http.Do(req)
 		if err != nil {
 			return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
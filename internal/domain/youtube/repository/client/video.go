@@ -69,6 +69,8 @@ func (c *Client) GetVideosByIDs(ctx context.Context, ids []string) ([]entity.Vid
 			return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
 		}
 
+		c.limiter.Take()
+
 		resp, err := c.http.Do(req)
 		if err != nil {
 			return nil, http.StatusInternalServerError, stack.Wrap(ctx, err, errors.ErrInternalServer)
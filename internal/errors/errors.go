@@ -8,24 +8,31 @@ import (
 
 // Error list.
 var (
-	ErrInternalDB           = errors.New("internal database error")
-	ErrInternalCache        = errors.New("internal cache error")
-	ErrInternalServer       = errors.New("internal server error")
-	ErrInvalidDBFormat      = errors.New("invalid db address")
-	ErrInvalidRequestFormat = errors.New("invalid request format")
-	ErrInvalidRequestData   = errors.New("invalid request data")
-	ErrInvalidMessageType   = errors.New("invalid message type")
-	ErrInvalidToken         = errors.New("invalid token or already expired")
-	ErrAdminOnly            = errors.New("admin only")
-	ErrWikiaPageNotFound    = errors.New("wikia page not found")
-	ErrInvalidID            = errors.New("invalid id")
-	ErrInvalidDate          = errors.New("invalid date")
-	ErrVtuberNotFound       = errors.New("vtuber not found")
-	ErrAgencyNotFound       = errors.New("agency not found")
-	ErrChannelNotFound      = errors.New("channel not found")
-	ErrUserNotFound         = errors.New("user not found")
-	ErrTierNotFound         = errors.New("tier list not found")
-	ErrUpdateNotAllowed     = errors.New("update not allowed")
+	ErrInternalDB               = errors.New("internal database error")
+	ErrInternalCache            = errors.New("internal cache error")
+	ErrInternalServer           = errors.New("internal server error")
+	ErrInvalidDBFormat          = errors.New("invalid db address")
+	ErrInvalidRequestFormat     = errors.New("invalid request format")
+	ErrInvalidRequestData       = errors.New("invalid request data")
+	ErrInvalidMessageType       = errors.New("invalid message type")
+	ErrInvalidToken             = errors.New("invalid token or already expired")
+	ErrAdminOnly                = errors.New("admin only")
+	ErrWikiaPageNotFound        = errors.New("wikia page not found")
+	ErrInvalidID                = errors.New("invalid id")
+	ErrInvalidDate              = errors.New("invalid date")
+	ErrVtuberNotFound           = errors.New("vtuber not found")
+	ErrVtuberSnapshotNotFound   = errors.New("vtuber snapshot not found")
+	ErrAgencyNotFound           = errors.New("agency not found")
+	ErrChannelNotFound          = errors.New("channel not found")
+	ErrChannelFetchFailed       = errors.New("failed to fetch channel data")
+	ErrNotEnoughHistory         = errors.New("not enough channel history to forecast")
+	ErrUserNotFound             = errors.New("user not found")
+	ErrTierNotFound             = errors.New("tier list not found")
+	ErrUpdateNotAllowed         = errors.New("update not allowed")
+	ErrInvalidWebSubTopic       = errors.New("invalid websub topic")
+	ErrInvalidWebSubSignature   = errors.New("invalid websub signature")
+	ErrInvalidEventSubSignature = errors.New("invalid eventsub signature")
+	ErrInvalidCursor            = errors.New("invalid cursor")
 )
 
 // ErrRequiredField is error for missing field.
@@ -67,3 +74,8 @@ func ErrUrlField(str string) error {
 func ErrDatetimeField(str, value string) error {
 	return fmt.Errorf("field %s must be in %s format", str, value)
 }
+
+// ErrInvalidFilter is error for invalid filter expression.
+func ErrInvalidFilter(str string) error {
+	return fmt.Errorf("invalid filter: %s", str)
+}
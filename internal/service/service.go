@@ -7,15 +7,21 @@ import (
 	bilibilRepository "github.com/rl404/shimakaze/internal/domain/bilibili/repository"
 	channelStatsHistoryRepository "github.com/rl404/shimakaze/internal/domain/channel_stats_history/repository"
 	languageRepository "github.com/rl404/shimakaze/internal/domain/language/repository"
+	milestoneRepository "github.com/rl404/shimakaze/internal/domain/milestone/repository"
 	niconicoRepository "github.com/rl404/shimakaze/internal/domain/niconico/repository"
 	nonVtuberRepository "github.com/rl404/shimakaze/internal/domain/non_vtuber/repository"
 	"github.com/rl404/shimakaze/internal/domain/publisher/entity"
 	publisherRepository "github.com/rl404/shimakaze/internal/domain/publisher/repository"
+	searchRepository "github.com/rl404/shimakaze/internal/domain/search/repository"
+	similarRepository "github.com/rl404/shimakaze/internal/domain/similar/repository"
 	ssoRepository "github.com/rl404/shimakaze/internal/domain/sso/repository"
+	streamSessionRepository "github.com/rl404/shimakaze/internal/domain/stream_session/repository"
 	tokenRepository "github.com/rl404/shimakaze/internal/domain/token/repository"
 	twitchRepository "github.com/rl404/shimakaze/internal/domain/twitch/repository"
 	userRepository "github.com/rl404/shimakaze/internal/domain/user/repository"
 	vtuberRepository "github.com/rl404/shimakaze/internal/domain/vtuber/repository"
+	vtuberChangeRepository "github.com/rl404/shimakaze/internal/domain/vtuber_change/repository"
+	websubRepository "github.com/rl404/shimakaze/internal/domain/websub/repository"
 	wikiaRepository "github.com/rl404/shimakaze/internal/domain/wikia/repository"
 	youtubeRepository "github.com/rl404/shimakaze/internal/domain/youtube/repository"
 )
@@ -30,8 +36,14 @@ type Service interface {
 	GetProfile(ctx context.Context, userID int64) (*User, int, error)
 
 	GetVtubers(ctx context.Context, params GetVtubersRequest) ([]vtuber, *pagination, int, error)
-	GetVtuberByID(ctx context.Context, id int64) (*vtuber, int, error)
+	GetVtuberByID(ctx context.Context, data GetVtuberByIDRequest) (*vtuber, int, error)
+	GetVtubersByIDs(ctx context.Context, data GetVtubersByIDsRequest) ([]vtuber, *batch, int, error)
 	GetVtuberChannelHistoriesByID(ctx context.Context, data GetVtuberChannelHistoriesRequest) ([]vtuberChannelHistory, int, error)
+	GetVtuberForecast(ctx context.Context, data GetVtuberForecastRequest) ([]vtuberForecast, int, error)
+	GetVtuberSimilars(ctx context.Context, data GetVtuberSimilarsRequest) ([]similarVtuber, int, error)
+	GetVtuberChanges(ctx context.Context, data GetVtuberChangesRequest) ([]vtuberChange, *pagination, int, error)
+	GetVtuberMilestones(ctx context.Context, data GetVtuberMilestonesRequest) ([]milestone, *pagination, int, error)
+	GetRecentMilestones(ctx context.Context, data GetRecentMilestonesRequest) ([]milestone, *pagination, int, error)
 	GetVtuberImages(ctx context.Context, shuffle bool, limit int) ([]vtuberImage, int, error)
 	GetVtuberFamilyTrees(ctx context.Context) (*vtuberFamilyTree, int, error)
 	GetVtuberAgencyTrees(ctx context.Context) (*vtuberAgencyTree, int, error)
@@ -62,15 +74,22 @@ type Service interface {
 	GetVtuberLanguageCount(ctx context.Context) ([]vtuberLanguageCount, int, error)
 	GetVtuberGenderCount(ctx context.Context) ([]vtuberGenderCount, int, error)
 	GetVtuberZodiacCount(ctx context.Context) ([]vtuberZodiacCount, int, error)
+	GetVtuberSubscriberGrowth(ctx context.Context, params GetVtuberSubscriberGrowthRequest) ([]vtuberSubscriberGrowth, *pagination, int, error)
 
 	GetAgencies(ctx context.Context, params GetAgenciesRequest) ([]agency, *pagination, int, error)
-	GetAgencyByID(ctx context.Context, id int64) (*agency, int, error)
+	GetAgencyByID(ctx context.Context, data GetAgencyByIDRequest) (*agency, int, error)
+	GetAgenciesByIDs(ctx context.Context, data GetAgenciesByIDsRequest) ([]agency, *batch, int, error)
+	GetAgencyMembers(ctx context.Context, data GetAgencyMembersRequest) ([]vtuber, int, error)
 	GetAgencyCount(ctx context.Context) (int, int, error)
 
 	GetVideos(ctx context.Context, params GetVideosRequest) ([]video, *pagination, int, error)
 
 	GetLanguages(ctx context.Context) ([]language, *pagination, int, error)
 
+	GetSuggestions(ctx context.Context, data GetSuggestionsRequest) ([]suggestion, int, error)
+	RefreshSearchIndex(ctx context.Context) (int, error)
+	RefreshStaleSearchIndex(ctx context.Context) (int, error)
+
 	GetWikiaImage(ctx context.Context, path string) ([]byte, int, error)
 
 	DeleteVtuberByID(ctx context.Context, id int64) (int, error)
@@ -81,6 +100,13 @@ type Service interface {
 	GetNonVtubers(ctx context.Context, params GetNonVtubersRequest) ([]nonVtuber, *pagination, int, error)
 	DeleteNonVtuberByID(ctx context.Context, id int64) (int, error)
 
+	GetFailingChannels(ctx context.Context, params GetFailingChannelsRequest) ([]failingChannel, *pagination, int, error)
+	GetChannelStatsAnomalies(ctx context.Context, params GetChannelStatsAnomaliesRequest) ([]channelStatsAnomaly, *pagination, int, error)
+
+	VerifyYoutubeWebSub(ctx context.Context, data VerifyYoutubeWebSubRequest) (string, int, error)
+	HandleYoutubeWebSub(ctx context.Context, body []byte, signature string) (int, error)
+	HandleTwitchEventSub(ctx context.Context, data TwitchEventSubRequest) (string, int, error)
+
 	ConsumeMessage(ctx context.Context, msg entity.Message) error
 
 	QueueMissingAgency(ctx context.Context, limit int) (int, int, error)
@@ -89,6 +115,12 @@ type Service interface {
 	QueueOldAgency(ctx context.Context, limit int) (int, int, error)
 	QueueOldActiveVtuber(ctx context.Context, limit int) (int, int, error)
 	QueueOldRetiredVtuber(ctx context.Context, limit int) (int, int, error)
+
+	RenewYoutubeWebSub(ctx context.Context) (int, int, error)
+	SubscribeTwitchEventSub(ctx context.Context) (int, int, error)
+
+	RollupChannelStats(ctx context.Context, rawAge, dailyAge int) (int, int, error)
+	UpdateSimilarVtubers(ctx context.Context, data UpdateSimilarVtubersRequest) (int, int, error)
 }
 
 type service struct {
@@ -106,6 +138,12 @@ type service struct {
 	sso                 ssoRepository.Repository
 	user                userRepository.Repository
 	token               tokenRepository.Repository
+	websub              websubRepository.Repository
+	streamSession       streamSessionRepository.Repository
+	vtuberChange        vtuberChangeRepository.Repository
+	milestone           milestoneRepository.Repository
+	search              searchRepository.Repository
+	similar             similarRepository.Repository
 }
 
 // New to create new service.
@@ -124,6 +162,12 @@ func New(
 	sso ssoRepository.Repository,
 	user userRepository.Repository,
 	token tokenRepository.Repository,
+	websub websubRepository.Repository,
+	streamSession streamSessionRepository.Repository,
+	vtuberChange vtuberChangeRepository.Repository,
+	milestone milestoneRepository.Repository,
+	search searchRepository.Repository,
+	similar similarRepository.Repository,
 ) Service {
 	return &service{
 		wikia:               wikia,
@@ -140,11 +184,35 @@ func New(
 		sso:                 sso,
 		user:                user,
 		token:               token,
+		websub:              websub,
+		streamSession:       streamSession,
+		vtuberChange:        vtuberChange,
+		milestone:           milestone,
+		search:              search,
+		similar:             similar,
 	}
 }
 
 type pagination struct {
-	Page  int `json:"page"`
-	Limit int `json:"limit"`
-	Total int `json:"total"`
+	Page       int    `json:"page"`
+	Limit      int    `json:"limit"`
+	Total      int    `json:"total"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type batch struct {
+	MissingIDs []int64 `json:"missing_ids"`
+}
+
+// getUniqueIDs to remove duplicate ids while keeping the order.
+func (s *service) getUniqueIDs(ids []int64) []int64 {
+	exist := make(map[int64]bool)
+	res := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if !exist[id] {
+			exist[id] = true
+			res = append(res, id)
+		}
+	}
+	return res
 }
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rl404/fairy/errors/stack"
+	channelStatsEntity "github.com/rl404/shimakaze/internal/domain/channel_stats_history/entity"
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+type failingChannel struct {
+	VtuberID      int64              `json:"vtuber_id"`
+	VtuberName    string             `json:"vtuber_name"`
+	ChannelID     string             `json:"channel_id"`
+	ChannelName   string             `json:"channel_name"`
+	ChannelType   entity.ChannelType `json:"channel_type"`
+	ChannelURL    string             `json:"channel_url"`
+	LastSuccessAt *time.Time         `json:"last_success_at"`
+	LastError     string             `json:"last_error"`
+	LastErrorCode int                `json:"last_error_code"`
+	FailCount     int                `json:"fail_count"`
+}
+
+// GetFailingChannelsRequest is get failing channel list request model.
+type GetFailingChannelsRequest struct {
+	ChannelType  entity.ChannelType `validate:"omitempty,oneof=YOUTUBE TWITCH BILIBILI NICONICO" mod:"trim,ucase"`
+	MinFailCount int                `validate:"required,gte=1" mod:"default=3"`
+	Page         int                `validate:"required,gte=1" mod:"default=1"`
+	Limit        int                `validate:"required,gte=-1" mod:"default=20"`
+}
+
+// GetFailingChannels to get channels failing to be fetched repeatedly.
+func (s *service) GetFailingChannels(ctx context.Context, data GetFailingChannelsRequest) ([]failingChannel, *pagination, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return nil, nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	channels, total, code, err := s.vtuber.GetFailingChannels(ctx, entity.GetFailingChannelsRequest{
+		ChannelType:  data.ChannelType,
+		MinFailCount: data.MinFailCount,
+		Page:         data.Page,
+		Limit:        data.Limit,
+	})
+	if err != nil {
+		return nil, nil, code, stack.Wrap(ctx, err)
+	}
+
+	res := make([]failingChannel, len(channels))
+	for i, c := range channels {
+		res[i] = failingChannel{
+			VtuberID:      c.VtuberID,
+			VtuberName:    c.VtuberName,
+			ChannelID:     c.ChannelID,
+			ChannelName:   c.ChannelName,
+			ChannelType:   c.ChannelType,
+			ChannelURL:    c.ChannelURL,
+			LastSuccessAt: c.LastSuccessAt,
+			LastError:     c.LastError,
+			LastErrorCode: c.LastErrorCode,
+			FailCount:     c.FailCount,
+		}
+	}
+
+	return res, &pagination{
+		Page:  data.Page,
+		Limit: data.Limit,
+		Total: total,
+	}, http.StatusOK, nil
+}
+
+type channelStatsAnomaly struct {
+	VtuberID    int64              `json:"vtuber_id"`
+	ChannelID   string             `json:"channel_id"`
+	ChannelType entity.ChannelType `json:"channel_type"`
+	Subscriber  int                `json:"subscriber"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+// GetChannelStatsAnomaliesRequest is get channel stats anomaly list request model.
+type GetChannelStatsAnomaliesRequest struct {
+	VtuberID    int64              `validate:"gte=0"`
+	ChannelType entity.ChannelType `validate:"omitempty,oneof=YOUTUBE TWITCH BILIBILI NICONICO" mod:"trim,ucase"`
+	Page        int                `validate:"required,gte=1" mod:"default=1"`
+	Limit       int                `validate:"required,gte=-1" mod:"default=20"`
+}
+
+// GetChannelStatsAnomalies to get channel stats flagged as anomaly.
+func (s *service) GetChannelStatsAnomalies(ctx context.Context, data GetChannelStatsAnomaliesRequest) ([]channelStatsAnomaly, *pagination, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return nil, nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	anomalies, total, code, err := s.channelStatsHistory.GetAnomalies(ctx, channelStatsEntity.GetAnomaliesRequest{
+		VtuberID:    data.VtuberID,
+		ChannelType: data.ChannelType,
+		Page:        data.Page,
+		Limit:       data.Limit,
+	})
+	if err != nil {
+		return nil, nil, code, stack.Wrap(ctx, err)
+	}
+
+	res := make([]channelStatsAnomaly, len(anomalies))
+	for i, a := range anomalies {
+		res[i] = channelStatsAnomaly{
+			VtuberID:    a.VtuberID,
+			ChannelID:   a.ChannelID,
+			ChannelType: a.ChannelType,
+			Subscriber:  a.Subscriber,
+			CreatedAt:   a.CreatedAt,
+		}
+	}
+
+	return res, &pagination{
+		Page:  data.Page,
+		Limit: data.Limit,
+		Total: total,
+	}, http.StatusOK, nil
+}
@@ -16,9 +16,10 @@ type nonVtuber struct {
 
 // GetNonVtubersRequest is get non-vtuber list request model.
 type GetNonVtubersRequest struct {
-	Name  string `validate:"omitempty,gte=3" mod:"trim,lcase"`
-	Page  int    `validate:"required,gte=1" mod:"default=1"`
-	Limit int    `validate:"required,gte=-1" mod:"default=20"`
+	Name   string `validate:"omitempty,gte=3" mod:"trim,lcase"`
+	Page   int    `validate:"required,gte=1" mod:"default=1"`
+	Limit  int    `validate:"required,gte=-1" mod:"default=20"`
+	Cursor string `mod:"trim"`
 }
 
 // GetNonVtubers to get non-vtuber list.
@@ -27,10 +28,11 @@ func (s *service) GetNonVtubers(ctx context.Context, data GetNonVtubersRequest)
 		return nil, nil, http.StatusBadRequest, stack.Wrap(ctx, err)
 	}
 
-	nonVtubers, total, code, err := s.nonVtuber.GetAll(ctx, entity.GetAllRequest{
-		Name:  data.Name,
-		Page:  data.Page,
-		Limit: data.Limit,
+	nonVtubers, total, nextCursor, code, err := s.nonVtuber.GetAll(ctx, entity.GetAllRequest{
+		Name:   data.Name,
+		Page:   data.Page,
+		Limit:  data.Limit,
+		Cursor: data.Cursor,
 	})
 	if err != nil {
 		return nil, nil, code, stack.Wrap(ctx, err)
@@ -45,9 +47,10 @@ func (s *service) GetNonVtubers(ctx context.Context, data GetNonVtubersRequest)
 	}
 
 	return res, &pagination{
-		Page:  data.Page,
-		Limit: data.Limit,
-		Total: total,
+		Page:       data.Page,
+		Limit:      data.Limit,
+		Total:      total,
+		NextCursor: nextCursor,
 	}, http.StatusOK, nil
 }
 
@@ -55,6 +55,10 @@ func (s *service) DeleteVtuberByID(ctx context.Context, id int64) (int, error) {
 		return code, stack.Wrap(ctx, err)
 	}
 
+	if code, err := s.search.SetStale(ctx); err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
 	return http.StatusOK, nil
 }
 
@@ -136,7 +140,12 @@ func (s *service) UpdateVtuberOverriddenFieldByID(ctx context.Context, data Vtub
 		}
 	}
 
-	code, err := s.vtuber.UpdateOverriddenFieldByID(ctx, data.ID, entity.OverriddenField{
+	vtuber, code, err := s.vtuber.GetByID(ctx, data.ID)
+	if err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	overriddenField := entity.OverriddenField{
 		DebutDate: entity.OverriddenDate{
 			Flag:  data.DebutDate.Flag,
 			Value: data.DebutDate.Value,
@@ -157,8 +166,14 @@ func (s *service) UpdateVtuberOverriddenFieldByID(ctx context.Context, data Vtub
 			Flag:  data.Channels.Flag,
 			Value: channels,
 		},
-	})
-	if err != nil {
+	}
+
+	if code, err := s.vtuber.UpdateOverriddenFieldByID(ctx, data.ID, overriddenField); err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	// Insert vtuber changes.
+	if code, err := s.vtuberChange.Create(ctx, s.getOverriddenFieldChanges(*vtuber, overriddenField)); err != nil {
 		return code, stack.Wrap(ctx, err)
 	}
 
@@ -7,21 +7,27 @@ import (
 
 	"github.com/rl404/fairy/errors/stack"
 	"github.com/rl404/shimakaze/internal/domain/agency/entity"
+	vtuberEntity "github.com/rl404/shimakaze/internal/domain/vtuber/entity"
 	"github.com/rl404/shimakaze/internal/utils"
 )
 
 type agency struct {
-	ID         int64     `json:"id"`
-	Name       string    `json:"name"`
-	Image      string    `json:"image"`
-	Member     int       `json:"member"`
-	Subscriber int       `json:"subscriber"`
-	UpdatedAt  time.Time `json:"updated_at"`
+	ID                 int64     `json:"id"`
+	Name               string    `json:"name"`
+	Image              string    `json:"image"`
+	Member             int       `json:"member"`
+	Subscriber         int       `json:"subscriber"`
+	YoutubeSubscriber  int       `json:"youtube_subscriber"`
+	TwitchSubscriber   int       `json:"twitch_subscriber"`
+	BilibiliSubscriber int       `json:"bilibili_subscriber"`
+	NiconicoSubscriber int       `json:"niconico_subscriber"`
+	TotalSubscriber    int       `json:"total_subscriber"`
+	UpdatedAt          time.Time `json:"updated_at"`
 }
 
 // GetAgenciesRequest is get agencies request model.
 type GetAgenciesRequest struct {
-	Sort  string `validate:"oneof=name -name member -member subscriber -subscriber" mod:"default=name,trim,lcase"`
+	Sort  string `validate:"oneof=name -name member -member subscriber -subscriber youtube_subscriber -youtube_subscriber twitch_subscriber -twitch_subscriber bilibili_subscriber -bilibili_subscriber niconico_subscriber -niconico_subscriber total_subscriber -total_subscriber" mod:"default=name,trim,lcase"`
 	Page  int    `validate:"required,gte=1" mod:"default=1"`
 	Limit int    `validate:"required,gte=-1" mod:"default=20"`
 }
@@ -44,12 +50,17 @@ func (s *service) GetAgencies(ctx context.Context, data GetAgenciesRequest) ([]a
 	res := make([]agency, len(agencies))
 	for i, a := range agencies {
 		res[i] = agency{
-			ID:         a.ID,
-			Name:       a.Name,
-			Image:      a.Image,
-			Member:     a.Member,
-			Subscriber: a.Subscriber,
-			UpdatedAt:  a.UpdatedAt,
+			ID:                 a.ID,
+			Name:               a.Name,
+			Image:              a.Image,
+			Member:             a.Member,
+			Subscriber:         a.Subscriber,
+			YoutubeSubscriber:  a.YoutubeSubscriber,
+			TwitchSubscriber:   a.TwitchSubscriber,
+			BilibiliSubscriber: a.BilibiliSubscriber,
+			NiconicoSubscriber: a.NiconicoSubscriber,
+			TotalSubscriber:    a.TotalSubscriber,
+			UpdatedAt:          a.UpdatedAt,
 		}
 	}
 
@@ -60,19 +71,146 @@ func (s *service) GetAgencies(ctx context.Context, data GetAgenciesRequest) ([]a
 	}, http.StatusOK, nil
 }
 
+// GetAgencyByIDRequest is get agency by id request model.
+type GetAgencyByIDRequest struct {
+	ID   int64  `validate:"required,gt=0"`
+	AsOf string `validate:"omitempty,datetime=2006-01-02" mod:"trim"`
+}
+
 // GetAgencyByID to get agency by id.
-func (s *service) GetAgencyByID(ctx context.Context, id int64) (*agency, int, error) {
-	a, code, err := s.agency.GetByID(ctx, id)
+// If as of date is set, the member and subscriber count
+// will be taken from the member snapshots at the end of the date.
+func (s *service) GetAgencyByID(ctx context.Context, data GetAgencyByIDRequest) (*agency, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	a, code, err := s.agency.GetByID(ctx, data.ID)
 	if err != nil {
 		return nil, code, stack.Wrap(ctx, err)
 	}
 
+	if data.AsOf != "" {
+		vtubers, code, err := s.getAgencyMemberSnapshots(ctx, data.ID, s.getAsOfTime(data.AsOf))
+		if err != nil {
+			return nil, code, stack.Wrap(ctx, err)
+		}
+
+		a.Member = len(vtubers)
+		a.Subscriber = s.getAgencySubscriber(vtubers)
+		a.YoutubeSubscriber, a.TwitchSubscriber, a.BilibiliSubscriber, a.NiconicoSubscriber, a.TotalSubscriber = s.getAgencyPlatformSubscriber(vtubers)
+	}
+
 	return &agency{
-		ID:         a.ID,
-		Name:       a.Name,
-		Image:      a.Image,
-		Member:     a.Member,
-		Subscriber: a.Subscriber,
-		UpdatedAt:  a.UpdatedAt,
+		ID:                 a.ID,
+		Name:               a.Name,
+		Image:              a.Image,
+		Member:             a.Member,
+		Subscriber:         a.Subscriber,
+		YoutubeSubscriber:  a.YoutubeSubscriber,
+		TwitchSubscriber:   a.TwitchSubscriber,
+		BilibiliSubscriber: a.BilibiliSubscriber,
+		NiconicoSubscriber: a.NiconicoSubscriber,
+		TotalSubscriber:    a.TotalSubscriber,
+		UpdatedAt:          a.UpdatedAt,
 	}, http.StatusOK, nil
 }
+
+// GetAgenciesByIDsRequest is get agencies by ids request model.
+type GetAgenciesByIDsRequest struct {
+	IDs []int64 `validate:"required,lte=100,dive,gt=0"`
+}
+
+// GetAgenciesByIDs to get agencies by ids.
+// The agencies are in the same order as the ids,
+// and ids not found are returned separately.
+func (s *service) GetAgenciesByIDs(ctx context.Context, data GetAgenciesByIDsRequest) ([]agency, *batch, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return nil, nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	ids := s.getUniqueIDs(data.IDs)
+
+	agencies, code, err := s.agency.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, code, stack.Wrap(ctx, err)
+	}
+
+	agencyMap := make(map[int64]entity.Agency)
+	for _, a := range agencies {
+		agencyMap[a.ID] = a
+	}
+
+	res := []agency{}
+	missing := batch{MissingIDs: []int64{}}
+	for _, id := range ids {
+		a, ok := agencyMap[id]
+		if !ok {
+			missing.MissingIDs = append(missing.MissingIDs, id)
+			continue
+		}
+
+		res = append(res, agency{
+			ID:                 a.ID,
+			Name:               a.Name,
+			Image:              a.Image,
+			Member:             a.Member,
+			Subscriber:         a.Subscriber,
+			YoutubeSubscriber:  a.YoutubeSubscriber,
+			TwitchSubscriber:   a.TwitchSubscriber,
+			BilibiliSubscriber: a.BilibiliSubscriber,
+			NiconicoSubscriber: a.NiconicoSubscriber,
+			TotalSubscriber:    a.TotalSubscriber,
+			UpdatedAt:          a.UpdatedAt,
+		})
+	}
+
+	return res, &missing, http.StatusOK, nil
+}
+
+// GetAgencyMembersRequest is get agency members request model.
+type GetAgencyMembersRequest struct {
+	ID   int64  `validate:"required,gt=0"`
+	AsOf string `validate:"omitempty,datetime=2006-01-02" mod:"trim"`
+}
+
+// GetAgencyMembers to get agency member list.
+// If as of date is set, the members will be taken
+// from the vtuber snapshots at the end of the date.
+func (s *service) GetAgencyMembers(ctx context.Context, data GetAgencyMembersRequest) ([]vtuber, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	if _, code, err := s.agency.GetByID(ctx, data.ID); err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	var vtubers []vtuberEntity.Vtuber
+	if data.AsOf != "" {
+		members, code, err := s.getAgencyMemberSnapshots(ctx, data.ID, s.getAsOfTime(data.AsOf))
+		if err != nil {
+			return nil, code, stack.Wrap(ctx, err)
+		}
+		vtubers = members
+	} else {
+		members, _, _, code, err := s.vtuber.GetAll(ctx, vtuberEntity.GetAllRequest{
+			Mode:     vtuberEntity.SearchModeAll,
+			AgencyID: data.ID,
+			Sort:     "name",
+			Page:     1,
+			Limit:    -1,
+		})
+		if err != nil {
+			return nil, code, stack.Wrap(ctx, err)
+		}
+		vtubers = members
+	}
+
+	res := make([]vtuber, len(vtubers))
+	for i, vt := range vtubers {
+		res[i] = s.vtuberFromEntity(vt)
+	}
+
+	return res, http.StatusOK, nil
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/rl404/fairy/errors/stack"
+	channelStatsEntity "github.com/rl404/shimakaze/internal/domain/channel_stats_history/entity"
+	vtuberEntity "github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+)
+
+const (
+	anomalyHistoryDays   = 30
+	anomalyMinDataPoints = 5
+	anomalyThreshold     = 3.5
+	anomalyMinDeviation  = 0.01
+
+	// Subscriber is accepted as the new level if this
+	// many consecutive points agree within the tolerance.
+	anomalyLevelPoints    = 3
+	anomalyLevelTolerance = 0.05
+)
+
+// RollupChannelStats to roll up old channel stats history.
+// Raw stats older than raw age (in days) are rolled up to
+// daily stats and daily stats older than daily age
+// (in months) are rolled up to monthly stats.
+func (s *service) RollupChannelStats(ctx context.Context, rawAge, dailyAge int) (int, int, error) {
+	now := time.Now().UTC()
+
+	rawBefore := now.AddDate(0, 0, -rawAge)
+	rawBefore = time.Date(rawBefore.Year(), rawBefore.Month(), rawBefore.Day(), 0, 0, 0, 0, time.UTC)
+
+	dailyBefore := now.AddDate(0, -dailyAge, 0)
+	dailyBefore = time.Date(dailyBefore.Year(), dailyBefore.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	dailyCnt, code, err := s.channelStatsHistory.RollupDaily(ctx, rawBefore)
+	if err != nil {
+		return dailyCnt, code, stack.Wrap(ctx, err)
+	}
+
+	monthlyCnt, code, err := s.channelStatsHistory.RollupMonthly(ctx, dailyBefore)
+	if err != nil {
+		return dailyCnt + monthlyCnt, code, stack.Wrap(ctx, err)
+	}
+
+	return dailyCnt + monthlyCnt, http.StatusOK, nil
+}
+
+// getSubscriberAnomalies to get channels whose new subscriber
+// is an outlier compared to their recent history. Returned
+// map value is the fetched (anomaly) subscriber.
+func (s *service) getSubscriberAnomalies(ctx context.Context, vtuberID int64, channels []vtuberEntity.Channel) (map[channelStatsEntity.ChannelStats]int, int, error) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	histories, code, err := s.channelStatsHistory.Get(ctx, channelStatsEntity.GetRequest{
+		VtuberID:    vtuberID,
+		StartDate:   today.AddDate(0, 0, -anomalyHistoryDays),
+		EndDate:     today,
+		Group:       channelStatsEntity.Daily,
+		Aggregation: channelStatsEntity.AggregationLast,
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	historyMap := make(map[channelStatsEntity.ChannelStats][]int)
+	for _, h := range histories {
+		key := channelStatsEntity.ChannelStats{
+			VtuberID:    h.VtuberID,
+			ChannelID:   h.ChannelID,
+			ChannelType: h.ChannelType,
+		}
+		historyMap[key] = append(historyMap[key], h.Subscriber)
+	}
+
+	recents, code, err := s.channelStatsHistory.GetRecent(ctx, channelStatsEntity.GetRecentRequest{
+		VtuberID: vtuberID,
+		Limit:    anomalyLevelPoints - 1,
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	recentMap := make(map[channelStatsEntity.ChannelStats][]channelStatsEntity.ChannelStats)
+	for _, r := range recents {
+		key := channelStatsEntity.ChannelStats{
+			VtuberID:    r.VtuberID,
+			ChannelID:   r.ChannelID,
+			ChannelType: r.ChannelType,
+		}
+		recentMap[key] = append(recentMap[key], r)
+	}
+
+	anomalies := make(map[channelStatsEntity.ChannelStats]int)
+	for _, channel := range channels {
+		if channel.IsStale {
+			continue
+		}
+
+		key := channelStatsEntity.ChannelStats{
+			VtuberID:    vtuberID,
+			ChannelID:   channel.ID,
+			ChannelType: channel.Type,
+		}
+
+		if s.isSubscriberAnomaly(channel.Subscriber, historyMap[key]) && !s.isSubscriberNewLevel(channel.Subscriber, recentMap[key]) {
+			anomalies[key] = channel.Subscriber
+		}
+	}
+
+	return anomalies, http.StatusOK, nil
+}
+
+// isSubscriberAnomaly to check if subscriber is an outlier
+// using modified z-score from median absolute deviation.
+// Zero is always an anomaly if the history is not zero.
+func (s *service) isSubscriberAnomaly(subscriber int, history []int) bool {
+	if len(history) == 0 {
+		return false
+	}
+
+	median := s.getMedian(history)
+
+	if subscriber == 0 {
+		return median > 0
+	}
+
+	if len(history) < anomalyMinDataPoints {
+		return false
+	}
+
+	deviations := make([]int, len(history))
+	for i, h := range history {
+		deviations[i] = int(math.Abs(float64(h) - median))
+	}
+
+	// Flat history has zero deviation so use a minimum
+	// deviation to not flag every small change.
+	mad := math.Max(s.getMedian(deviations), median*anomalyMinDeviation)
+	if mad == 0 {
+		return false
+	}
+
+	return 0.6745*math.Abs(float64(subscriber)-median)/mad > anomalyThreshold
+}
+
+// isSubscriberNewLevel to check if the recent stats agree
+// with the subscriber. Flagged stats are not in the history,
+// so without this a real level change (e.g. subscriber purge)
+// would be flagged forever. Zero is never accepted.
+func (s *service) isSubscriberNewLevel(subscriber int, recents []channelStatsEntity.ChannelStats) bool {
+	if subscriber == 0 || len(recents) < anomalyLevelPoints-1 {
+		return false
+	}
+
+	for _, r := range recents[:anomalyLevelPoints-1] {
+		if math.Abs(float64(r.Subscriber-subscriber)) > float64(subscriber)*anomalyLevelTolerance {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *service) getMedian(values []int) float64 {
+	sorted := make([]int, len(values))
+	copy(sorted, values)
+	sort.Ints(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return float64(sorted[mid-1]+sorted[mid]) / 2
+	}
+	return float64(sorted[mid])
+}
+
+// excludeZeroAnomalies to replace anomaly zero subscriber
+// with the existing one so it will not affect vtuber
+// and agency subscriber.
+func (s *service) excludeZeroAnomalies(vtuberID int64, channels []vtuberEntity.Channel, anomalies map[channelStatsEntity.ChannelStats]int, existingVtuber *vtuberEntity.Vtuber) []vtuberEntity.Channel {
+	for i, channel := range channels {
+		if channel.Subscriber != 0 {
+			continue
+		}
+
+		if _, ok := anomalies[channelStatsEntity.ChannelStats{
+			VtuberID:    vtuberID,
+			ChannelID:   channel.ID,
+			ChannelType: channel.Type,
+		}]; !ok {
+			continue
+		}
+
+		if existingChannel := s.getExistingChannel(channel, existingVtuber); existingChannel != nil {
+			channels[i].Subscriber = existingChannel.Subscriber
+		}
+	}
+	return channels
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	channelStatsEntity "github.com/rl404/shimakaze/internal/domain/channel_stats_history/entity"
+	channelStatsHistoryRepository "github.com/rl404/shimakaze/internal/domain/channel_stats_history/repository"
+)
+
+// rollupRepository records rollup dates.
+type rollupRepository struct {
+	channelStatsHistoryRepository.Repository
+	dailyBefore   time.Time
+	monthlyBefore time.Time
+}
+
+func (r *rollupRepository) RollupDaily(_ context.Context, before time.Time) (int, int, error) {
+	r.dailyBefore = before
+	return 2, http.StatusOK, nil
+}
+
+func (r *rollupRepository) RollupMonthly(_ context.Context, before time.Time) (int, int, error) {
+	r.monthlyBefore = before
+	return 3, http.StatusOK, nil
+}
+
+func TestRollupChannelStats(t *testing.T) {
+	repo := &rollupRepository{}
+	s := &service{channelStatsHistory: repo}
+
+	now := time.Now().UTC()
+	cnt, _, err := s.RollupChannelStats(context.Background(), 30, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cnt != 5 {
+		t.Errorf("count = %d, want 5", cnt)
+	}
+
+	raw := now.AddDate(0, 0, -30)
+	if want := time.Date(raw.Year(), raw.Month(), raw.Day(), 0, 0, 0, 0, time.UTC); !repo.dailyBefore.Equal(want) {
+		t.Errorf("daily rollup before = %v, want %v", repo.dailyBefore, want)
+	}
+
+	daily := now.AddDate(0, -12, 0)
+	if want := time.Date(daily.Year(), daily.Month(), 1, 0, 0, 0, 0, time.UTC); !repo.monthlyBefore.Equal(want) {
+		t.Errorf("monthly rollup before = %v, want %v", repo.monthlyBefore, want)
+	}
+}
+
+func TestIsSubscriberAnomaly(t *testing.T) {
+	flat := []int{1000, 1000, 1000, 1000, 1000, 1000}
+	growing := []int{1000, 1010, 1020, 1030, 1040, 1050}
+
+	tests := []struct {
+		name       string
+		subscriber int
+		history    []int
+		want       bool
+	}{
+		{name: "no-history", subscriber: 1000, history: nil, want: false},
+		{name: "zero", subscriber: 0, history: []int{1000}, want: true},
+		{name: "zero-with-zero-history", subscriber: 0, history: []int{0, 0}, want: false},
+		{name: "few-points", subscriber: 10, history: []int{1000, 1000}, want: false},
+		{name: "flat-small-change", subscriber: 1005, history: flat, want: false},
+		{name: "flat-drop", subscriber: 900, history: flat, want: true},
+		{name: "flat-spike", subscriber: 2000, history: flat, want: true},
+		{name: "growing-next", subscriber: 1060, history: growing, want: false},
+		{name: "growing-drop", subscriber: 500, history: growing, want: true},
+	}
+
+	s := &service{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.isSubscriberAnomaly(tt.subscriber, tt.history); got != tt.want {
+				t.Fatalf("isSubscriberAnomaly() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetMedian(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   float64
+	}{
+		{name: "single", values: []int{5}, want: 5},
+		{name: "odd", values: []int{9, 1, 5}, want: 5},
+		{name: "even", values: []int{4, 1, 3, 2}, want: 2.5},
+		{name: "duplicate", values: []int{7, 7, 1, 7}, want: 7},
+	}
+
+	s := &service{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := append([]int{}, tt.values...)
+			if got := s.getMedian(values); got != tt.want {
+				t.Fatalf("getMedian() = %v, want %v", got, tt.want)
+			}
+			for i := range values {
+				if values[i] != tt.values[i] {
+					t.Fatalf("getMedian() modified the values")
+				}
+			}
+		})
+	}
+}
+
+func TestIsSubscriberNewLevel(t *testing.T) {
+	stats := func(subscribers ...int) []channelStatsEntity.ChannelStats {
+		res := make([]channelStatsEntity.ChannelStats, len(subscribers))
+		for i, s := range subscribers {
+			res[i] = channelStatsEntity.ChannelStats{Subscriber: s, IsAnomaly: true}
+		}
+		return res
+	}
+
+	tests := []struct {
+		name       string
+		subscriber int
+		recents    []channelStatsEntity.ChannelStats
+		want       bool
+	}{
+		{name: "no-recent", subscriber: 500, recents: nil, want: false},
+		{name: "not-enough-recent", subscriber: 500, recents: stats(500), want: false},
+		{name: "agree", subscriber: 500, recents: stats(500, 498), want: true},
+		{name: "agree-within-tolerance", subscriber: 500, recents: stats(520, 480), want: true},
+		{name: "out-of-tolerance", subscriber: 500, recents: stats(500, 1000), want: false},
+		{name: "first-jump", subscriber: 500, recents: stats(1000, 1000), want: false},
+		{name: "zero", subscriber: 0, recents: stats(0, 0), want: false},
+	}
+
+	s := &service{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.isSubscriberNewLevel(tt.subscriber, tt.recents); got != tt.want {
+				t.Fatalf("isSubscriberNewLevel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@ func (s *service) ConsumeMessage(ctx context.Context, msg entity.Message) error
 		return stack.Wrap(ctx, s.consumeParseVtuber(ctx, msg.ID, msg.Forced))
 	case entity.TypeParseAgency:
 		return stack.Wrap(ctx, s.consumeParseAgency(ctx, msg.ID, msg.Forced))
+	case entity.TypeRefreshChannel:
+		return stack.Wrap(ctx, s.consumeRefreshChannel(ctx, msg.ID, msg.ChannelID))
 	default:
 		return stack.Wrap(ctx, errors.ErrInvalidMessageType)
 	}
@@ -57,3 +59,10 @@ func (s *service) consumeParseAgency(ctx context.Context, id int64, forced bool)
 
 	return nil
 }
+
+func (s *service) consumeRefreshChannel(ctx context.Context, id int64, channelID string) error {
+	if _, err := s.updateChannel(ctx, id, channelID); err != nil {
+		return stack.Wrap(ctx, err)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/rl404/fairy/errors/stack"
+	streamSessionEntity "github.com/rl404/shimakaze/internal/domain/stream_session/entity"
+	twitchEntity "github.com/rl404/shimakaze/internal/domain/twitch/entity"
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+// TwitchEventSubRequest is twitch eventsub request model.
+type TwitchEventSubRequest struct {
+	MessageID        string `validate:"required" mod:"trim"`
+	MessageType      string `validate:"required,oneof=webhook_callback_verification notification revocation" mod:"trim"`
+	MessageTimestamp string `validate:"required" mod:"trim"`
+	MessageSignature string `validate:"required" mod:"trim"`
+	Body             []byte
+}
+
+// HandleTwitchEventSub to handle twitch eventsub webhook.
+func (s *service) HandleTwitchEventSub(ctx context.Context, data TwitchEventSubRequest) (string, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return "", http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	event, code, err := s.twitch.ParseEventSub(ctx, twitchEntity.EventSubRequest{
+		MessageID:        data.MessageID,
+		MessageType:      data.MessageType,
+		MessageTimestamp: data.MessageTimestamp,
+		MessageSignature: data.MessageSignature,
+		Body:             data.Body,
+	})
+	if err != nil {
+		return "", code, stack.Wrap(ctx, err)
+	}
+
+	if event.IsDuplicate {
+		return "", http.StatusOK, nil
+	}
+
+	switch event.MessageType {
+	case twitchEntity.EventSubMessageVerification:
+		return event.Challenge, http.StatusOK, nil
+	case twitchEntity.EventSubMessageNotification:
+		if code, err := s.handleTwitchStreamEvent(ctx, *event); err != nil {
+			return "", code, stack.Wrap(ctx, err)
+		}
+	case twitchEntity.EventSubMessageRevocation:
+		if code, err := s.handleTwitchEventSubRevocation(ctx, *event); err != nil {
+			return "", code, stack.Wrap(ctx, err)
+		}
+	}
+
+	// Only mark as handled when succeed so
+	// twitch retry is still processed.
+	if code, err := s.twitch.SetEventSubHandled(ctx, event.MessageID); err != nil {
+		return "", code, stack.Wrap(ctx, err)
+	}
+
+	return "", http.StatusOK, nil
+}
+
+func (s *service) handleTwitchStreamEvent(ctx context.Context, event twitchEntity.EventSub) (int, error) {
+	vtuberID, code, err := s.vtuber.GetIDByChannelID(ctx, entity.ChannelTwitch, event.BroadcasterID)
+	if err != nil {
+		if code == http.StatusNotFound {
+			return http.StatusOK, nil
+		}
+		return code, stack.Wrap(ctx, err)
+	}
+
+	switch event.SubscriptionType {
+	case twitchEntity.EventSubStreamOnline:
+		if code, err := s.vtuber.UpdateChannelLiveByID(ctx, vtuberID, entity.ChannelTwitch, event.BroadcasterID, true); err != nil {
+			return code, stack.Wrap(ctx, err)
+		}
+
+		startedAt := event.Timestamp
+		if event.StartedAt != nil {
+			startedAt = *event.StartedAt
+		}
+
+		if code, err := s.streamSession.Start(ctx, streamSessionEntity.StreamSession{
+			ID:          event.StreamID,
+			VtuberID:    vtuberID,
+			ChannelID:   event.BroadcasterID,
+			ChannelType: entity.ChannelTwitch,
+			StartedAt:   startedAt,
+		}); err != nil {
+			return code, stack.Wrap(ctx, err)
+		}
+	case twitchEntity.EventSubStreamOffline:
+		if code, err := s.vtuber.UpdateChannelLiveByID(ctx, vtuberID, entity.ChannelTwitch, event.BroadcasterID, false); err != nil {
+			return code, stack.Wrap(ctx, err)
+		}
+
+		if code, err := s.streamSession.End(ctx, entity.ChannelTwitch, event.BroadcasterID, event.Timestamp); err != nil {
+			return code, stack.Wrap(ctx, err)
+		}
+	}
+
+	return http.StatusOK, nil
+}
+
+func (s *service) handleTwitchEventSubRevocation(ctx context.Context, event twitchEntity.EventSub) (int, error) {
+	vtuberID, code, err := s.vtuber.GetIDByChannelID(ctx, entity.ChannelTwitch, event.BroadcasterID)
+	if err != nil {
+		if code == http.StatusNotFound {
+			return http.StatusOK, nil
+		}
+		return code, stack.Wrap(ctx, err)
+	}
+
+	// Offline event will not come anymore so
+	// the channel should not be stuck as live.
+	if event.SubscriptionType == twitchEntity.EventSubStreamOffline {
+		if code, err := s.vtuber.UpdateChannelLiveByID(ctx, vtuberID, entity.ChannelTwitch, event.BroadcasterID, false); err != nil {
+			return code, stack.Wrap(ctx, err)
+		}
+
+		if code, err := s.streamSession.End(ctx, entity.ChannelTwitch, event.BroadcasterID, event.Timestamp); err != nil {
+			return code, stack.Wrap(ctx, err)
+		}
+	}
+
+	// Other status means the channel or the subscription
+	// is gone so it should not be resubscribed.
+	if event.Status == twitchEntity.EventSubRevokedFailuresExceeded {
+		if code, err := s.twitch.SubscribeStreamEvents(ctx, event.BroadcasterID); err != nil {
+			return code, stack.Wrap(ctx, err)
+		}
+	}
+
+	return http.StatusOK, nil
+}
+
+// SubscribeTwitchEventSub to subscribe twitch stream events.
+func (s *service) SubscribeTwitchEventSub(ctx context.Context) (int, int, error) {
+	channelIDs, code, err := s.vtuber.GetActiveChannelIDs(ctx, entity.ChannelTwitch)
+	if err != nil {
+		return 0, code, stack.Wrap(ctx, err)
+	}
+
+	var cnt int
+	for _, channelID := range channelIDs {
+		if code, err := s.twitch.SubscribeStreamEvents(ctx, channelID); err != nil {
+			return cnt, code, stack.Wrap(ctx, err)
+		}
+		cnt++
+	}
+
+	return cnt, http.StatusOK, nil
+}
@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/rl404/fairy/errors/stack"
+	historyEntity "github.com/rl404/shimakaze/internal/domain/channel_stats_history/entity"
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/errors"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+type forecastModel string
+
+const (
+	forecastLinear    forecastModel = "LINEAR"
+	forecastLogLinear forecastModel = "LOG_LINEAR"
+)
+
+const (
+	forecastMinDataPoints    = 14
+	forecastMaxMilestoneDays = 3650
+)
+
+type vtuberForecast struct {
+	ChannelID     string               `json:"channel_id"`
+	ChannelType   entity.ChannelType   `json:"channel_type"`
+	Model         forecastModel        `json:"model"`
+	DataPoints    int                  `json:"data_points"`
+	Subscriber    int                  `json:"subscriber"`
+	DailyGrowth   float64              `json:"daily_growth"`
+	RSquared      float64              `json:"r_squared"`
+	Projections   []forecastProjection `json:"projections"`
+	NextMilestone *forecastMilestone   `json:"next_milestone"`
+}
+
+type forecastProjection struct {
+	Date       time.Time `json:"date"`
+	Subscriber int       `json:"subscriber"`
+	Lower      int       `json:"lower"`
+	Upper      int       `json:"upper"`
+}
+
+type forecastMilestone struct {
+	Milestone int        `json:"milestone"`
+	Date      *time.Time `json:"date"`
+}
+
+// GetVtuberForecastRequest is get vtuber forecast request model.
+type GetVtuberForecastRequest struct {
+	ID          int64  `validate:"required,gt=0"`
+	Model       string `validate:"oneof=LINEAR LOG_LINEAR" mod:"trim,ucase,default=LINEAR"`
+	HistoryDays int    `validate:"required,gte=14,lte=365" mod:"default=90"`
+	Days        int    `validate:"required,gte=1,lte=365" mod:"default=30"`
+}
+
+// GetVtuberForecast to get vtuber channel subscriber forecast.
+//
+// Each channel daily subscriber history is fitted with
+// least squares regression and projected with 95%
+// prediction interval. Channels with too few data
+// points are not forecasted.
+func (s *service) GetVtuberForecast(ctx context.Context, data GetVtuberForecastRequest) ([]vtuberForecast, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	vt, code, err := s.vtuber.GetByID(ctx, data.ID)
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	model := forecastModel(data.Model)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	histories, code, err := s.channelStatsHistory.Get(ctx, historyEntity.GetRequest{
+		VtuberID:    data.ID,
+		StartDate:   today.AddDate(0, 0, -data.HistoryDays),
+		EndDate:     today,
+		Group:       historyEntity.Daily,
+		Aggregation: historyEntity.AggregationLast,
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	historyMap := make(map[string][]historyEntity.ChannelStats)
+	for _, h := range histories {
+		key := string(h.ChannelType) + h.ChannelID
+		historyMap[key] = append(historyMap[key], h)
+	}
+
+	res := []vtuberForecast{}
+	for _, channel := range vt.Channels {
+		var xs, ys []float64
+		for _, h := range historyMap[string(channel.Type)+channel.ID] {
+			if model == forecastLogLinear && h.Subscriber <= 0 {
+				continue
+			}
+
+			xs = append(xs, h.CreatedAt.Sub(today).Hours()/24)
+			ys = append(ys, s.toForecastSpace(model, float64(h.Subscriber)))
+		}
+
+		if len(xs) < forecastMinDataPoints {
+			continue
+		}
+
+		reg := s.fitRegression(xs, ys)
+
+		forecast := vtuberForecast{
+			ChannelID:   channel.ID,
+			ChannelType: channel.Type,
+			Model:       model,
+			DataPoints:  reg.n,
+			Subscriber:  channel.Subscriber,
+			DailyGrowth: math.Round((s.fromForecastSpace(model, reg.predict(1))-s.fromForecastSpace(model, reg.predict(0)))*100) / 100,
+			RSquared:    math.Round(reg.rSquared*10000) / 10000,
+			Projections: make([]forecastProjection, data.Days),
+		}
+
+		tValue := s.getTValue(reg.n - 2)
+		for i := range forecast.Projections {
+			x := float64(i + 1)
+			y, se := reg.predict(x), reg.predictionStdErr(x)
+			forecast.Projections[i] = forecastProjection{
+				Date:       today.AddDate(0, 0, i+1),
+				Subscriber: int(math.Round(s.fromForecastSpace(model, y))),
+				Lower:      int(math.Round(math.Max(0, s.fromForecastSpace(model, y-tValue*se)))),
+				Upper:      int(math.Round(s.fromForecastSpace(model, y+tValue*se))),
+			}
+		}
+
+		nextMilestone, code, err := s.milestone.GetNext(ctx, channel.Subscriber)
+		if err != nil {
+			return nil, code, stack.Wrap(ctx, err)
+		}
+
+		if nextMilestone > 0 {
+			forecast.NextMilestone = &forecastMilestone{
+				Milestone: nextMilestone,
+				Date:      s.getMilestoneDate(reg, today, s.toForecastSpace(model, float64(nextMilestone))),
+			}
+		}
+
+		res = append(res, forecast)
+	}
+
+	if len(res) == 0 {
+		return nil, http.StatusUnprocessableEntity, stack.Wrap(ctx, errors.ErrNotEnoughHistory)
+	}
+
+	return res, http.StatusOK, nil
+}
+
+func (s *service) toForecastSpace(model forecastModel, subscriber float64) float64 {
+	if model == forecastLogLinear {
+		return math.Log(subscriber)
+	}
+	return subscriber
+}
+
+func (s *service) fromForecastSpace(model forecastModel, value float64) float64 {
+	if model == forecastLogLinear {
+		return math.Exp(value)
+	}
+	return value
+}
+
+// getMilestoneDate to get the date when the trend line
+// reaches the milestone. Return nil if the trend is
+// not growing or too far in the future.
+func (s *service) getMilestoneDate(reg regression, today time.Time, milestone float64) *time.Time {
+	if reg.slope <= 0 {
+		return nil
+	}
+
+	days := math.Ceil((milestone - reg.intercept) / reg.slope)
+	if days > forecastMaxMilestoneDays {
+		return nil
+	}
+
+	date := today.AddDate(0, 0, int(math.Max(0, days)))
+	return &date
+}
+
+type regression struct {
+	n         int
+	slope     float64
+	intercept float64
+	meanX     float64
+	sxx       float64
+	stdErr    float64
+	rSquared  float64
+}
+
+func (s *service) fitRegression(xs, ys []float64) regression {
+	n := float64(len(xs))
+
+	var sumX, sumY float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+	}
+
+	meanX, meanY := sumX/n, sumY/n
+
+	var sxx, sxy, syy float64
+	for i := range xs {
+		dx, dy := xs[i]-meanX, ys[i]-meanY
+		sxx += dx * dx
+		sxy += dx * dy
+		syy += dy * dy
+	}
+
+	reg := regression{n: len(xs), intercept: meanY, meanX: meanX, sxx: sxx}
+	if sxx == 0 {
+		return reg
+	}
+
+	reg.slope = sxy / sxx
+	reg.intercept = meanY - reg.slope*meanX
+
+	var sse float64
+	for i := range xs {
+		e := ys[i] - reg.predict(xs[i])
+		sse += e * e
+	}
+
+	if reg.n > 2 {
+		reg.stdErr = math.Sqrt(sse / (n - 2))
+	}
+
+	if syy > 0 {
+		reg.rSquared = 1 - sse/syy
+	}
+
+	return reg
+}
+
+func (r regression) predict(x float64) float64 {
+	return r.intercept + r.slope*x
+}
+
+func (r regression) predictionStdErr(x float64) float64 {
+	if r.sxx == 0 {
+		return r.stdErr
+	}
+	return r.stdErr * math.Sqrt(1+1/float64(r.n)+math.Pow(x-r.meanX, 2)/r.sxx)
+}
+
+// getTValue to get two-sided 95% t-distribution
+// critical value for the degree of freedom.
+func (s *service) getTValue(df int) float64 {
+	table := []float64{
+		12.706, 4.303, 3.182, 2.776, 2.571, 2.447, 2.365, 2.306, 2.262, 2.228,
+		2.201, 2.179, 2.160, 2.145, 2.131, 2.120, 2.110, 2.101, 2.093, 2.086,
+		2.080, 2.074, 2.069, 2.064, 2.060, 2.056, 2.052, 2.048, 2.045, 2.042,
+	}
+
+	switch {
+	case df < 1:
+		return table[0]
+	case df <= len(table):
+		return table[df-1]
+	case df <= 40:
+		return 2.021
+	case df <= 60:
+		return 2.000
+	case df <= 120:
+		return 1.980
+	default:
+		return 1.960
+	}
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFitRegression(t *testing.T) {
+	tests := []struct {
+		name      string
+		xs        []float64
+		ys        []float64
+		slope     float64
+		intercept float64
+		stdErr    float64
+		rSquared  float64
+	}{
+		{name: "perfect-line", xs: []float64{0, 1, 2, 3, 4}, ys: []float64{1, 3, 5, 7, 9}, slope: 2, intercept: 1, stdErr: 0, rSquared: 1},
+		{name: "noisy", xs: []float64{0, 1, 2, 3}, ys: []float64{1, 3, 2, 4}, slope: 0.8, intercept: 1.3, stdErr: math.Sqrt(0.9), rSquared: 0.64},
+		{name: "flat-y", xs: []float64{0, 1, 2}, ys: []float64{5, 5, 5}, slope: 0, intercept: 5, stdErr: 0, rSquared: 0},
+		{name: "same-x", xs: []float64{1, 1, 1}, ys: []float64{2, 4, 6}, slope: 0, intercept: 4, stdErr: 0, rSquared: 0},
+		{name: "two-points", xs: []float64{0, 10}, ys: []float64{100, 200}, slope: 10, intercept: 100, stdErr: 0, rSquared: 1},
+	}
+
+	s := &service{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := s.fitRegression(tt.xs, tt.ys)
+			for _, c := range []struct {
+				field     string
+				got, want float64
+			}{
+				{"slope", reg.slope, tt.slope},
+				{"intercept", reg.intercept, tt.intercept},
+				{"stdErr", reg.stdErr, tt.stdErr},
+				{"rSquared", reg.rSquared, tt.rSquared},
+			} {
+				if math.Abs(c.got-c.want) > 1e-9 {
+					t.Errorf("%s = %v, want %v", c.field, c.got, c.want)
+				}
+			}
+			if reg.n != len(tt.xs) {
+				t.Errorf("n = %d, want %d", reg.n, len(tt.xs))
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rl404/fairy/errors/stack"
+	milestoneEntity "github.com/rl404/shimakaze/internal/domain/milestone/entity"
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+type milestone struct {
+	VtuberID    int64              `json:"vtuber_id"`
+	VtuberName  string             `json:"vtuber_name"`
+	VtuberImage string             `json:"vtuber_image"`
+	ChannelID   string             `json:"channel_id"`
+	ChannelName string             `json:"channel_name"`
+	ChannelType entity.ChannelType `json:"channel_type"`
+	Milestone   int                `json:"milestone"`
+	Subscriber  int                `json:"subscriber"`
+	ReachedAt   time.Time          `json:"reached_at"`
+}
+
+// GetVtuberMilestonesRequest is get vtuber milestone list request model.
+type GetVtuberMilestonesRequest struct {
+	ID          int64              `validate:"required,gte=1"`
+	ChannelType entity.ChannelType `validate:"omitempty,oneof=YOUTUBE TWITCH BILIBILI NICONICO" mod:"trim,ucase"`
+	Page        int                `validate:"required,gte=1" mod:"default=1"`
+	Limit       int                `validate:"required,gte=-1" mod:"default=20"`
+}
+
+// GetVtuberMilestones to get vtuber milestone list.
+func (s *service) GetVtuberMilestones(ctx context.Context, data GetVtuberMilestonesRequest) ([]milestone, *pagination, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return nil, nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	milestones, total, code, err := s.milestone.GetAll(ctx, milestoneEntity.GetAllRequest{
+		VtuberID:    data.ID,
+		ChannelType: data.ChannelType,
+		Page:        data.Page,
+		Limit:       data.Limit,
+	})
+	if err != nil {
+		return nil, nil, code, stack.Wrap(ctx, err)
+	}
+
+	return s.milestonesFromEntity(milestones), &pagination{
+		Page:  data.Page,
+		Limit: data.Limit,
+		Total: total,
+	}, http.StatusOK, nil
+}
+
+// GetRecentMilestonesRequest is get recent milestone list request model.
+type GetRecentMilestonesRequest struct {
+	ChannelType  entity.ChannelType `validate:"omitempty,oneof=YOUTUBE TWITCH BILIBILI NICONICO" mod:"trim,ucase"`
+	MinMilestone int                `validate:"gte=0"`
+	Days         int                `validate:"required,gte=1,lte=365" mod:"default=30"`
+	Page         int                `validate:"required,gte=1" mod:"default=1"`
+	Limit        int                `validate:"required,gte=-1" mod:"default=20"`
+}
+
+// GetRecentMilestones to get recent milestone list.
+func (s *service) GetRecentMilestones(ctx context.Context, data GetRecentMilestonesRequest) ([]milestone, *pagination, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return nil, nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	startDate := time.Now().AddDate(0, 0, -data.Days)
+
+	milestones, total, code, err := s.milestone.GetAll(ctx, milestoneEntity.GetAllRequest{
+		ChannelType:  data.ChannelType,
+		MinMilestone: data.MinMilestone,
+		StartDate:    &startDate,
+		Page:         data.Page,
+		Limit:        data.Limit,
+	})
+	if err != nil {
+		return nil, nil, code, stack.Wrap(ctx, err)
+	}
+
+	return s.milestonesFromEntity(milestones), &pagination{
+		Page:  data.Page,
+		Limit: data.Limit,
+		Total: total,
+	}, http.StatusOK, nil
+}
+
+func (s *service) milestonesFromEntity(milestones []milestoneEntity.Milestone) []milestone {
+	res := make([]milestone, len(milestones))
+	for i, m := range milestones {
+		res[i] = milestone{
+			VtuberID:    m.VtuberID,
+			VtuberName:  m.VtuberName,
+			VtuberImage: m.VtuberImage,
+			ChannelID:   m.ChannelID,
+			ChannelName: m.ChannelName,
+			ChannelType: m.ChannelType,
+			Milestone:   m.Milestone,
+			Subscriber:  m.Subscriber,
+			ReachedAt:   m.ReachedAt,
+		}
+	}
+	return res
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/rl404/fairy/errors/stack"
+	agencyEntity "github.com/rl404/shimakaze/internal/domain/agency/entity"
+	searchEntity "github.com/rl404/shimakaze/internal/domain/search/entity"
+	vtuberEntity "github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+const searchMaxResult = 1000
+
+// RefreshSearchIndex to rebuild in-memory vtuber search
+// and suggestion index from the database.
+func (s *service) RefreshSearchIndex(ctx context.Context) (int, error) {
+	vtubers, code, err := s.vtuber.GetAllForSearch(ctx)
+	if err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	agencies, _, code, err := s.agency.GetAll(ctx, agencyEntity.GetAllRequest{
+		Sort:  "name",
+		Page:  1,
+		Limit: -1,
+	})
+	if err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	docs := make([]searchEntity.Vtuber, len(vtubers))
+	for i, vt := range vtubers {
+		docs[i] = searchEntity.Vtuber{
+			ID:            vt.ID,
+			Name:          vt.Name,
+			OriginalNames: vt.OriginalNames,
+			Nicknames:     vt.Nicknames,
+		}
+	}
+
+	if code, err := s.search.SetVtubers(ctx, docs); err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	if code, err := s.search.SetSuggestions(ctx, s.getSuggestions(vtubers, agencies)); err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	return http.StatusOK, nil
+}
+
+// RefreshStaleSearchIndex to rebuild in-memory vtuber search
+// and suggestion index only if it is marked as stale.
+func (s *service) RefreshStaleSearchIndex(ctx context.Context) (int, error) {
+	stale, code, err := s.search.IsStale(ctx)
+	if err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	if !stale {
+		return http.StatusOK, nil
+	}
+
+	return s.RefreshSearchIndex(ctx)
+}
+
+// isSearchIndexChanged to check if vtuber update
+// changes the search and suggestion index data.
+func (s *service) isSearchIndexChanged(existing *vtuberEntity.Vtuber, vtuber vtuberEntity.Vtuber) bool {
+	if existing == nil {
+		return true
+	}
+
+	return existing.Name != vtuber.Name ||
+		existing.Image != vtuber.Image ||
+		!slices.Equal(existing.OriginalNames, vtuber.OriginalNames) ||
+		!slices.Equal(existing.Nicknames, vtuber.Nicknames) ||
+		!slices.Equal(existing.CharacterDesigners, vtuber.CharacterDesigners) ||
+		!slices.Equal(existing.Character2DModelers, vtuber.Character2DModelers) ||
+		!slices.Equal(existing.Character3DModelers, vtuber.Character3DModelers)
+}
+
+// searchVtubers to get vtuber ids matching the query
+// ordered by relevance.
+func (s *service) searchVtubers(ctx context.Context, query string) ([]int64, int, error) {
+	results, code, err := s.search.SearchVtubers(ctx, query, searchMaxResult)
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	ids := make([]int64, len(results))
+	for i, r := range results {
+		ids[i] = r.ID
+	}
+
+	return ids, http.StatusOK, nil
+}
+
+// getSuggestions to get vtuber, agency, and creator
+// suggestions. Creator popularity is their vtuber count.
+func (s *service) getSuggestions(vtubers []vtuberEntity.Vtuber, agencies []agencyEntity.Agency) []searchEntity.Suggestion {
+	var suggestions []searchEntity.Suggestion
+	for _, vt := range vtubers {
+		keywords := append([]string{vt.Name}, vt.OriginalNames...)
+		suggestions = append(suggestions, searchEntity.Suggestion{
+			Type:       searchEntity.SuggestionVtuber,
+			ID:         vt.ID,
+			Name:       vt.Name,
+			Image:      vt.Image,
+			Keywords:   append(keywords, vt.Nicknames...),
+			Popularity: vt.Subscriber,
+		})
+	}
+
+	for _, a := range agencies {
+		suggestions = append(suggestions, searchEntity.Suggestion{
+			Type:       searchEntity.SuggestionAgency,
+			ID:         a.ID,
+			Name:       a.Name,
+			Image:      a.Image,
+			Keywords:   []string{a.Name},
+			Popularity: a.Member,
+		})
+	}
+
+	creators := []struct {
+		suggestionType searchEntity.SuggestionType
+		names          func(vtuberEntity.Vtuber) []string
+	}{
+		{suggestionType: searchEntity.SuggestionCharacterDesigner, names: func(v vtuberEntity.Vtuber) []string { return v.CharacterDesigners }},
+		{suggestionType: searchEntity.SuggestionCharacter2DModeler, names: func(v vtuberEntity.Vtuber) []string { return v.Character2DModelers }},
+		{suggestionType: searchEntity.SuggestionCharacter3DModeler, names: func(v vtuberEntity.Vtuber) []string { return v.Character3DModelers }},
+	}
+
+	for _, creator := range creators {
+		var names []string
+		countMap := make(map[string]int)
+		for _, vt := range vtubers {
+			for _, name := range creator.names(vt) {
+				if countMap[name] == 0 {
+					names = append(names, name)
+				}
+				countMap[name]++
+			}
+		}
+
+		for _, name := range names {
+			suggestions = append(suggestions, searchEntity.Suggestion{
+				Type:       creator.suggestionType,
+				Name:       name,
+				Keywords:   []string{name},
+				Popularity: countMap[name],
+			})
+		}
+	}
+
+	return suggestions
+}
+
+// getSearchKeys to get unique normalized vtuber names.
+func (s *service) getSearchKeys(vtuber vtuberEntity.Vtuber) []string {
+	names := append([]string{vtuber.Name}, vtuber.OriginalNames...)
+	names = append(names, vtuber.Nicknames...)
+
+	keys := []string{}
+	keyMap := make(map[string]bool)
+	for _, name := range names {
+		key := utils.NormalizeSearchText(name)
+		if key == "" || keyMap[key] {
+			continue
+		}
+		keyMap[key] = true
+		keys = append(keys, key)
+	}
+
+	return keys
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+)
+
+func TestIsSearchIndexChanged(t *testing.T) {
+	existing := &entity.Vtuber{
+		Name:               "Usada Pekora",
+		Image:              "pekora.png",
+		OriginalNames:      []string{"兎田ぺこら"},
+		Nicknames:          []string{"Peko"},
+		CharacterDesigners: []string{"Yuuki Hagure"},
+		Subscriber:         1000,
+	}
+
+	tests := []struct {
+		name     string
+		existing *entity.Vtuber
+		update   func(v *entity.Vtuber)
+		want     bool
+	}{
+		{name: "new", existing: nil, update: func(v *entity.Vtuber) {}, want: true},
+		{name: "same", existing: existing, update: func(v *entity.Vtuber) {}, want: false},
+		{name: "subscriber", existing: existing, update: func(v *entity.Vtuber) { v.Subscriber = 2000 }, want: false},
+		{name: "name", existing: existing, update: func(v *entity.Vtuber) { v.Name = "Pekora" }, want: true},
+		{name: "image", existing: existing, update: func(v *entity.Vtuber) { v.Image = "new.png" }, want: true},
+		{name: "nickname", existing: existing, update: func(v *entity.Vtuber) { v.Nicknames = append(v.Nicknames, "Pekochan") }, want: true},
+		{name: "creator", existing: existing, update: func(v *entity.Vtuber) { v.CharacterDesigners = nil }, want: true},
+	}
+
+	s := &service{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vtuber := *existing
+			vtuber.Nicknames = append([]string{}, existing.Nicknames...)
+			tt.update(&vtuber)
+
+			if got := s.isSearchIndexChanged(tt.existing, vtuber); got != tt.want {
+				t.Fatalf("isSearchIndexChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/similar/entity"
+	vtuberEntity "github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/errors"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+const (
+	similarDebutRange      = 365 // days
+	similarSubscriberRange = 2   // order of magnitude
+)
+
+type similarVtuber struct {
+	ID     int64        `json:"id"`
+	Name   string       `json:"name"`
+	Image  string       `json:"image"`
+	Score  float64      `json:"score"`
+	Scores similarScore `json:"scores"`
+}
+
+type similarScore struct {
+	Agency        float64 `json:"agency"`
+	Language      float64 `json:"language"`
+	Creator       float64 `json:"creator"`
+	Debut         float64 `json:"debut"`
+	StreamingHour float64 `json:"streaming_hour"`
+	Subscriber    float64 `json:"subscriber"`
+}
+
+// GetVtuberSimilarsRequest is get similar vtubers request model.
+type GetVtuberSimilarsRequest struct {
+	ID    int64 `validate:"required,gt=0"`
+	Limit int   `validate:"required,gte=1,lte=50" mod:"default=10"`
+}
+
+// GetVtuberSimilars to get precomputed similar vtubers.
+func (s *service) GetVtuberSimilars(ctx context.Context, data GetVtuberSimilarsRequest) ([]similarVtuber, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	if _, code, err := s.vtuber.GetByID(ctx, data.ID); err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	similars, code, err := s.similar.GetByVtuberID(ctx, data.ID)
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	if len(similars) > data.Limit {
+		similars = similars[:data.Limit]
+	}
+
+	res := make([]similarVtuber, len(similars))
+	for i, sm := range similars {
+		res[i] = similarVtuber{
+			ID:    sm.ID,
+			Name:  sm.Name,
+			Image: sm.Image,
+			Score: sm.Score,
+			Scores: similarScore{
+				Agency:        sm.AgencyScore,
+				Language:      sm.LanguageScore,
+				Creator:       sm.CreatorScore,
+				Debut:         sm.DebutScore,
+				StreamingHour: sm.StreamingHourScore,
+				Subscriber:    sm.SubscriberScore,
+			},
+		}
+	}
+
+	return res, http.StatusOK, nil
+}
+
+// UpdateSimilarVtubersRequest is update similar vtubers request model.
+type UpdateSimilarVtubersRequest struct {
+	Limit               int     `validate:"required,gt=0"`
+	AgencyWeight        float64 `validate:"gte=0"`
+	LanguageWeight      float64 `validate:"gte=0"`
+	CreatorWeight       float64 `validate:"gte=0"`
+	DebutWeight         float64 `validate:"gte=0"`
+	StreamingHourWeight float64 `validate:"gte=0"`
+	SubscriberWeight    float64 `validate:"gte=0"`
+}
+
+type similarProfile struct {
+	vtuber        vtuberEntity.Vtuber
+	agencies      map[string]bool
+	languages     map[string]bool
+	creators      map[string]bool
+	hours         []float64
+	logSubscriber float64
+}
+
+// UpdateSimilarVtubers to precompute similar vtubers of
+// all vtubers. Each factor score is in 0-1 range and the
+// final score is their weighted average.
+func (s *service) UpdateSimilarVtubers(ctx context.Context, data UpdateSimilarVtubersRequest) (int, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return 0, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	totalWeight := data.AgencyWeight + data.LanguageWeight + data.CreatorWeight + data.DebutWeight + data.StreamingHourWeight + data.SubscriberWeight
+	if totalWeight == 0 {
+		return 0, http.StatusBadRequest, stack.Wrap(ctx, errors.ErrInvalidRequestData)
+	}
+
+	vtubers, code, err := s.vtuber.GetAllForSimilar(ctx)
+	if err != nil {
+		return 0, code, stack.Wrap(ctx, err)
+	}
+
+	profiles := make([]similarProfile, len(vtubers))
+	for i, vt := range vtubers {
+		profiles[i] = s.getSimilarProfile(vt)
+	}
+
+	for i, p1 := range profiles {
+		var similars []entity.Similar
+		for j, p2 := range profiles {
+			if i == j {
+				continue
+			}
+
+			sm := entity.Similar{
+				ID:                 p2.vtuber.ID,
+				Name:               p2.vtuber.Name,
+				Image:              p2.vtuber.Image,
+				AgencyScore:        s.getJaccard(p1.agencies, p2.agencies),
+				LanguageScore:      s.getJaccard(p1.languages, p2.languages),
+				CreatorScore:       s.getJaccard(p1.creators, p2.creators),
+				DebutScore:         s.getDebutSimilarity(p1.vtuber, p2.vtuber),
+				StreamingHourScore: s.getCosine(p1.hours, p2.hours),
+				SubscriberScore:    s.getSubscriberSimilarity(p1.logSubscriber, p2.logSubscriber),
+			}
+
+			sm.Score = (data.AgencyWeight*sm.AgencyScore +
+				data.LanguageWeight*sm.LanguageScore +
+				data.CreatorWeight*sm.CreatorScore +
+				data.DebutWeight*sm.DebutScore +
+				data.StreamingHourWeight*sm.StreamingHourScore +
+				data.SubscriberWeight*sm.SubscriberScore) / totalWeight
+
+			if sm.Score > 0 {
+				similars = append(similars, sm)
+			}
+		}
+
+		sort.Slice(similars, func(a, b int) bool {
+			if similars[a].Score != similars[b].Score {
+				return similars[a].Score > similars[b].Score
+			}
+			return similars[a].ID < similars[b].ID
+		})
+
+		if len(similars) > data.Limit {
+			similars = similars[:data.Limit]
+		}
+
+		for k := range similars {
+			similars[k] = s.roundSimilar(similars[k])
+		}
+
+		if code, err := s.similar.UpdateByVtuberID(ctx, p1.vtuber.ID, similars); err != nil {
+			return i, code, stack.Wrap(ctx, err)
+		}
+	}
+
+	return len(profiles), http.StatusOK, nil
+}
+
+func (s *service) getSimilarProfile(vt vtuberEntity.Vtuber) similarProfile {
+	p := similarProfile{
+		vtuber:    vt,
+		agencies:  make(map[string]bool),
+		languages: make(map[string]bool),
+		creators:  make(map[string]bool),
+	}
+
+	for _, a := range vt.Agencies {
+		p.agencies[strconv.FormatInt(a.ID, 10)] = true
+	}
+
+	for _, l := range vt.Languages {
+		p.languages[strconv.FormatInt(l.ID, 10)] = true
+	}
+
+	for _, creators := range [][]string{vt.CharacterDesigners, vt.Character2DModelers, vt.Character3DModelers} {
+		for _, c := range creators {
+			p.creators[c] = true
+		}
+	}
+
+	hours := make([]float64, 24)
+	var hasHour bool
+	for _, c := range vt.Channels {
+		for _, v := range c.Videos {
+			if v.StartDate != nil {
+				hours[v.StartDate.UTC().Hour()]++
+				hasHour = true
+			}
+		}
+	}
+
+	if hasHour {
+		p.hours = hours
+	}
+
+	if vt.Subscriber > 0 {
+		p.logSubscriber = math.Log10(float64(vt.Subscriber) + 1)
+	}
+
+	return p
+}
+
+// getJaccard to get jaccard index of 2 sets.
+func (s *service) getJaccard(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	var intersection int
+	for k := range a {
+		if b[k] {
+			intersection++
+		}
+	}
+
+	return float64(intersection) / float64(len(a)+len(b)-intersection)
+}
+
+// getCosine to get cosine similarity of 2 vectors.
+func (s *service) getCosine(a, b []float64) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return dot / math.Sqrt(normA*normB)
+}
+
+// getDebutSimilarity to get debut date closeness.
+// Debut more than a year apart is not similar.
+func (s *service) getDebutSimilarity(a, b vtuberEntity.Vtuber) float64 {
+	if a.DebutDate == nil || b.DebutDate == nil {
+		return 0
+	}
+
+	days := math.Abs(a.DebutDate.Sub(*b.DebutDate).Hours() / 24)
+	return math.Max(0, 1-days/similarDebutRange)
+}
+
+// getSubscriberSimilarity to get subscriber closeness
+// in log scale. Subscriber 2 orders of magnitude apart
+// is not similar.
+func (s *service) getSubscriberSimilarity(a, b float64) float64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return math.Max(0, 1-math.Abs(a-b)/similarSubscriberRange)
+}
+
+func (s *service) roundSimilar(sm entity.Similar) entity.Similar {
+	sm.Score = s.roundScore(sm.Score)
+	sm.AgencyScore = s.roundScore(sm.AgencyScore)
+	sm.LanguageScore = s.roundScore(sm.LanguageScore)
+	sm.CreatorScore = s.roundScore(sm.CreatorScore)
+	sm.DebutScore = s.roundScore(sm.DebutScore)
+	sm.StreamingHourScore = s.roundScore(sm.StreamingHourScore)
+	sm.SubscriberScore = s.roundScore(sm.SubscriberScore)
+	return sm
+}
+
+func (s *service) roundScore(v float64) float64 {
+	return math.Round(v*10000) / 10000
+}
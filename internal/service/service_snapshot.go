@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rl404/fairy/errors/stack"
+	historyEntity "github.com/rl404/shimakaze/internal/domain/channel_stats_history/entity"
+	vtuberEntity "github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+// getAsOfTime to convert as of date to the end of the date.
+func (s *service) getAsOfTime(date string) time.Time {
+	return utils.StrToTime("2006-01-02", date).AddDate(0, 0, 1).Add(-time.Nanosecond)
+}
+
+func (s *service) getVtuberSnapshot(ctx context.Context, id int64, asOf time.Time) (*vtuberEntity.Vtuber, int, error) {
+	vtuber, code, err := s.vtuber.GetSnapshotByID(ctx, id, asOf)
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	vtubers, code, err := s.fillSnapshotSubscribers(ctx, []vtuberEntity.Vtuber{*vtuber}, asOf)
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	return &vtubers[0], http.StatusOK, nil
+}
+
+func (s *service) getAgencyMemberSnapshots(ctx context.Context, agencyID int64, asOf time.Time) ([]vtuberEntity.Vtuber, int, error) {
+	vtubers, code, err := s.vtuber.GetSnapshotsByAgencyID(ctx, agencyID, asOf)
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	vtubers, code, err = s.fillSnapshotSubscribers(ctx, vtubers, asOf)
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	return vtubers, http.StatusOK, nil
+}
+
+// fillSnapshotSubscribers to replace snapshot channel subscribers
+// with the latest channel stats history before the time.
+func (s *service) fillSnapshotSubscribers(ctx context.Context, vtubers []vtuberEntity.Vtuber, asOf time.Time) ([]vtuberEntity.Vtuber, int, error) {
+	if len(vtubers) == 0 {
+		return vtubers, http.StatusOK, nil
+	}
+
+	ids := make([]int64, len(vtubers))
+	for i, vtuber := range vtubers {
+		ids[i] = vtuber.ID
+	}
+
+	histories, code, err := s.channelStatsHistory.GetLatest(ctx, historyEntity.GetLatestRequest{
+		VtuberIDs: ids,
+		AsOf:      asOf,
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	subscriberMap := make(map[historyEntity.ChannelStats]int)
+	for _, history := range histories {
+		subscriberMap[historyEntity.ChannelStats{
+			VtuberID:    history.VtuberID,
+			ChannelID:   history.ChannelID,
+			ChannelType: history.ChannelType,
+		}] = history.Subscriber
+	}
+
+	for i, vtuber := range vtubers {
+		subscriber := 0
+		for j, channel := range vtuber.Channels {
+			if sub, ok := subscriberMap[historyEntity.ChannelStats{
+				VtuberID:    vtuber.ID,
+				ChannelID:   channel.ID,
+				ChannelType: channel.Type,
+			}]; ok {
+				vtubers[i].Channels[j].Subscriber = sub
+			}
+
+			subscriber = max(subscriber, vtubers[i].Channels[j].Subscriber)
+		}
+
+		vtubers[i].Subscriber = subscriber
+		vtubers[i].YoutubeSubscriber, vtubers[i].TwitchSubscriber, vtubers[i].BilibiliSubscriber, vtubers[i].NiconicoSubscriber, vtubers[i].TotalSubscriber = s.getPlatformSubscriber(vtubers[i].Channels)
+	}
+
+	return vtubers, http.StatusOK, nil
+}
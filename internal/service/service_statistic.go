@@ -38,8 +38,15 @@ func (s *service) GetVtuberAverageActiveTime(ctx context.Context) (float64, int,
 }
 
 type vtuberStatusCount struct {
+	Active   int                 `json:"active"`
+	Retired  int                 `json:"retired"`
+	Activity vtuberActivityCount `json:"activity"`
+}
+
+type vtuberActivityCount struct {
 	Active  int `json:"active"`
-	Retired int `json:"retired"`
+	Hiatus  int `json:"hiatus"`
+	Dormant int `json:"dormant"`
 }
 
 // GetVtuberStatusCount to get vtuber status count.
@@ -51,6 +58,11 @@ func (s *service) GetVtuberStatusCount(ctx context.Context) (*vtuberStatusCount,
 	return &vtuberStatusCount{
 		Active:  cnt.Active,
 		Retired: cnt.Retired,
+		Activity: vtuberActivityCount{
+			Active:  cnt.ActivityActive,
+			Hiatus:  cnt.ActivityHiatus,
+			Dormant: cnt.ActivityDormant,
+		},
 	}, http.StatusOK, nil
 }
 
@@ -146,8 +158,9 @@ type vtuberSubscriberCount struct {
 
 // GetVtuberSubscriberCountRequest is get vtuber subscriber count request.
 type GetVtuberSubscriberCountRequest struct {
-	Interval int `validate:"required,gte=10000" mod:"default=100000"`
-	Max      int `validate:"required,lte=5000000" mod:"default=5000000"`
+	Interval int                       `validate:"required,gte=10000" mod:"default=100000"`
+	Max      int                       `validate:"required,lte=5000000" mod:"default=5000000"`
+	Platform entity.SubscriberPlatform `validate:"omitempty,oneof=YOUTUBE TWITCH BILIBILI NICONICO TOTAL" mod:"trim,ucase"`
 }
 
 // GetVtuberSubscriberCount to get vtuber subscriber count.
@@ -156,7 +169,7 @@ func (s *service) GetVtuberSubscriberCount(ctx context.Context, data GetVtuberSu
 		return nil, http.StatusBadRequest, stack.Wrap(ctx, err)
 	}
 
-	cnt, code, err := s.vtuber.GetSubscriberCount(ctx, data.Interval, data.Max)
+	cnt, code, err := s.vtuber.GetSubscriberCount(ctx, data.Interval, data.Max, data.Platform)
 	if err != nil {
 		return nil, code, stack.Wrap(ctx, err)
 	}
@@ -527,3 +540,76 @@ func (s *service) GetVtuberZodiacCount(ctx context.Context) ([]vtuberZodiacCount
 
 	return res, http.StatusOK, nil
 }
+
+// GetVtuberSubscriberGrowthRequest is get vtuber subscriber growth request model.
+type GetVtuberSubscriberGrowthRequest struct {
+	ChannelType    entity.ChannelType `validate:"omitempty,oneof=YOUTUBE TWITCH BILIBILI NICONICO" mod:"trim,ucase"`
+	AgencyID       int64              `validate:"omitempty,gte=1"`
+	LanguageID     int64              `validate:"omitempty,gte=1"`
+	ExcludeActive  bool               ``
+	ExcludeRetired bool               ``
+	Sort           string             `validate:"oneof=subscriber -subscriber growth_7d -growth_7d growth_30d -growth_30d growth_365d -growth_365d percent_7d -percent_7d percent_30d -percent_30d percent_365d -percent_365d" mod:"default=-growth_30d,trim,lcase"`
+	Page           int                `validate:"required,gte=1" mod:"default=1"`
+	Limit          int                `validate:"required,gte=-1" mod:"default=20"`
+}
+
+type vtuberSubscriberGrowth struct {
+	VtuberID    int64              `json:"vtuber_id"`
+	VtuberName  string             `json:"vtuber_name"`
+	VtuberImage string             `json:"vtuber_image"`
+	ChannelID   string             `json:"channel_id"`
+	ChannelName string             `json:"channel_name"`
+	ChannelType entity.ChannelType `json:"channel_type"`
+	Subscriber  int                `json:"subscriber"`
+	Growth7D    subscriberGrowth   `json:"growth_7d"`
+	Growth30D   subscriberGrowth   `json:"growth_30d"`
+	Growth365D  subscriberGrowth   `json:"growth_365d"`
+}
+
+type subscriberGrowth struct {
+	Subscriber int     `json:"subscriber"`
+	Percent    float64 `json:"percent"`
+}
+
+// GetVtuberSubscriberGrowth to get vtuber channel subscriber growth.
+func (s *service) GetVtuberSubscriberGrowth(ctx context.Context, data GetVtuberSubscriberGrowthRequest) ([]vtuberSubscriberGrowth, *pagination, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return nil, nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	growths, total, code, err := s.vtuber.GetSubscriberGrowth(ctx, entity.GetSubscriberGrowthRequest{
+		ChannelType:    data.ChannelType,
+		AgencyID:       data.AgencyID,
+		LanguageID:     data.LanguageID,
+		ExcludeActive:  data.ExcludeActive,
+		ExcludeRetired: data.ExcludeRetired,
+		Sort:           data.Sort,
+		Page:           data.Page,
+		Limit:          data.Limit,
+	})
+	if err != nil {
+		return nil, nil, code, stack.Wrap(ctx, err)
+	}
+
+	res := make([]vtuberSubscriberGrowth, len(growths))
+	for i, g := range growths {
+		res[i] = vtuberSubscriberGrowth{
+			VtuberID:    g.VtuberID,
+			VtuberName:  g.VtuberName,
+			VtuberImage: g.VtuberImage,
+			ChannelID:   g.ChannelID,
+			ChannelName: g.ChannelName,
+			ChannelType: g.ChannelType,
+			Subscriber:  g.Subscriber,
+			Growth7D:    subscriberGrowth{Subscriber: g.Growth.Day7.Subscriber, Percent: g.Growth.Day7.Percent},
+			Growth30D:   subscriberGrowth{Subscriber: g.Growth.Day30.Subscriber, Percent: g.Growth.Day30.Percent},
+			Growth365D:  subscriberGrowth{Subscriber: g.Growth.Day365.Subscriber, Percent: g.Growth.Day365.Percent},
+		}
+	}
+
+	return res, &pagination{
+		Page:  data.Page,
+		Limit: data.Limit,
+		Total: total,
+	}, http.StatusOK, nil
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/search/entity"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+type suggestion struct {
+	Type  entity.SuggestionType `json:"type"`
+	ID    int64                 `json:"id"`
+	Name  string                `json:"name"`
+	Image string                `json:"image"`
+}
+
+// GetSuggestionsRequest is get suggestions request model.
+type GetSuggestionsRequest struct {
+	Query string `validate:"required" mod:"trim"`
+	Limit int    `validate:"required,gte=1,lte=50" mod:"default=10"`
+}
+
+// GetSuggestions to get vtuber, agency, and creator
+// suggestions for autocomplete.
+func (s *service) GetSuggestions(ctx context.Context, data GetSuggestionsRequest) ([]suggestion, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	suggestions, code, err := s.search.Suggest(ctx, data.Query, data.Limit)
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	res := make([]suggestion, len(suggestions))
+	for i, sg := range suggestions {
+		res[i] = suggestion{
+			Type:  sg.Type,
+			ID:    sg.ID,
+			Name:  sg.Name,
+			Image: sg.Image,
+		}
+	}
+
+	return res, http.StatusOK, nil
+}
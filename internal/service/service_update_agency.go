@@ -19,13 +19,18 @@ func (s *service) updateAgency(ctx context.Context, id int64) (int, error) {
 			if code, err := s.agency.DeleteByID(ctx, id); err != nil {
 				return code, stack.Wrap(ctx, err)
 			}
+
+			// Rebuild search index.
+			if code, err := s.search.SetStale(ctx); err != nil {
+				return code, stack.Wrap(ctx, err)
+			}
 			return http.StatusOK, nil
 		}
 		return code, stack.Wrap(ctx, err)
 	}
 
 	// Get members.
-	vtubers, total, code, err := s.vtuber.GetAll(ctx, vtuberEntity.GetAllRequest{
+	vtubers, total, _, code, err := s.vtuber.GetAll(ctx, vtuberEntity.GetAllRequest{
 		Mode:     vtuberEntity.SearchModeAll,
 		AgencyID: page.ID,
 		Page:     1,
@@ -35,7 +40,33 @@ func (s *service) updateAgency(ctx context.Context, id int64) (int, error) {
 		return code, stack.Wrap(ctx, err)
 	}
 
-	// Get total subs.
+	youtube, twitch, bilibili, niconico, totalSubscriber := s.getAgencyPlatformSubscriber(vtubers)
+
+	// Update data.
+	if code, err := s.agency.UpdateByID(ctx, id, entity.Agency{
+		ID:                 page.ID,
+		Name:               page.Title,
+		Image:              s.getAgencyLogo(ctx, page.Content),
+		Member:             total,
+		Subscriber:         s.getAgencySubscriber(vtubers),
+		YoutubeSubscriber:  youtube,
+		TwitchSubscriber:   twitch,
+		BilibiliSubscriber: bilibili,
+		NiconicoSubscriber: niconico,
+		TotalSubscriber:    totalSubscriber,
+	}); err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	// Rebuild search index.
+	if code, err := s.search.SetStale(ctx); err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	return http.StatusOK, nil
+}
+
+func (s *service) getAgencySubscriber(vtubers []vtuberEntity.Vtuber) int {
 	subsTotal, channelMap := 0, make(map[string]bool)
 	for _, vtuber := range vtubers {
 		max, channelID := 0, ""
@@ -51,19 +82,26 @@ func (s *service) updateAgency(ctx context.Context, id int64) (int, error) {
 
 		channelMap[channelID] = true
 	}
+	return subsTotal
+}
 
-	// Update data.
-	if code, err := s.agency.UpdateByID(ctx, id, entity.Agency{
-		ID:         page.ID,
-		Name:       page.Title,
-		Image:      s.getAgencyLogo(ctx, page.Content),
-		Member:     total,
-		Subscriber: subsTotal,
-	}); err != nil {
-		return code, stack.Wrap(ctx, err)
+// getAgencyPlatformSubscriber to get subscriber sum of
+// all member channels for each platform and the total.
+// Channel shared by multiple members is counted once.
+func (s *service) getAgencyPlatformSubscriber(vtubers []vtuberEntity.Vtuber) (int, int, int, int, int) {
+	var channels []vtuberEntity.Channel
+	channelMap := make(map[string]bool)
+	for _, vtuber := range vtubers {
+		for _, channel := range vtuber.Channels {
+			key := string(channel.Type) + channel.ID
+			if channelMap[key] {
+				continue
+			}
+			channelMap[key] = true
+			channels = append(channels, channel)
+		}
 	}
-
-	return http.StatusOK, nil
+	return s.getPlatformSubscriber(channels)
 }
 
 func (s *service) getAgencyLogo(ctx context.Context, data string) string {
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	_errors "errors"
+	"net/http"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/errors"
+	"github.com/rl404/shimakaze/pkg/breaker"
+)
+
+func (s *service) updateChannel(ctx context.Context, vtuberID int64, channelID string) (int, error) {
+	vtuber, code, err := s.vtuber.GetByID(ctx, vtuberID)
+	if err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	// Refresh only the requested channel.
+	var updated bool
+	var channelErrs []channelError
+	for i, channel := range vtuber.Channels {
+		if channel.ID != channelID {
+			continue
+		}
+
+		updated = true
+
+		newChannel, code, err := s.fillChannel(ctx, channel, vtuber.RetirementDate, vtuber)
+		if err != nil {
+			// Keep old channel data, only record the failure.
+			if _errors.Is(err, breaker.ErrOpen) {
+				vtuber.Channels[i].IsStale = true
+			} else {
+				vtuber.Channels[i] = s.setChannelHealth(channel, &channel, code, err)
+			}
+			channelErrs = append(channelErrs, channelError{index: i, channel: channel, code: code, err: err})
+			continue
+		}
+
+		vtuber.Channels[i] = s.setChannelHealth(newChannel, &channel, code, nil)
+
+		// Detect channel subscriber anomaly.
+		anomalies, code, err := s.getSubscriberAnomalies(ctx, vtuber.ID, vtuber.Channels[i:i+1])
+		if err != nil {
+			return code, stack.Wrap(ctx, err)
+		}
+
+		if len(anomalies) > 0 && vtuber.Channels[i].Subscriber == 0 {
+			vtuber.Channels[i].Subscriber = channel.Subscriber
+		}
+
+		// Insert channel stats history.
+		isAnomaly, code, err := s.createChannelStat(ctx, vtuber.ID, vtuber.Channels[i], &channel, anomalies)
+		if err != nil {
+			return code, stack.Wrap(ctx, err)
+		}
+
+		if isAnomaly {
+			continue
+		}
+
+		// Insert channel milestones.
+		if code, err := s.createChannelMilestones(ctx, *vtuber, vtuber.Channels[i], channel.Subscriber); err != nil {
+			return code, stack.Wrap(ctx, err)
+		}
+	}
+
+	if !updated {
+		return http.StatusOK, nil
+	}
+
+	vtuber.Subscriber, vtuber.MonthlySubscriber, vtuber.VideoCount, vtuber.AverageVideoLength, vtuber.TotalVideoLength = s.getChannelSummary(vtuber.DebutDate, vtuber.Channels)
+	vtuber.YoutubeSubscriber, vtuber.TwitchSubscriber, vtuber.BilibiliSubscriber, vtuber.NiconicoSubscriber, vtuber.TotalSubscriber = s.getPlatformSubscriber(vtuber.Channels)
+	vtuber.LastActivityDate = s.getLastActivityDate(vtuber.Channels, vtuber)
+
+	// Get channel subscriber growth.
+	channels, code, err := s.fillChannelGrowth(ctx, vtuber.ID, vtuber.Channels)
+	if err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+	vtuber.Channels = channels
+
+	if code, err := s.vtuber.UpdateByID(ctx, vtuber.ID, *vtuber); err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	// Report failed channel after the health is saved.
+	if code, err := s.joinChannelErrors(channelErrs); err != nil {
+		return code, stack.Wrap(ctx, err, errors.ErrChannelFetchFailed)
+	}
+
+	return http.StatusOK, nil
+}
@@ -2,19 +2,26 @@ package service
 
 import (
 	"context"
+	_errors "errors"
 	"fmt"
 	"math"
 	"net/http"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/newrelic/go-agent/v3/newrelic"
 	"github.com/rl404/fairy/errors/stack"
 	"github.com/rl404/shimakaze/internal/domain/agency/entity"
 	channelStatsEntity "github.com/rl404/shimakaze/internal/domain/channel_stats_history/entity"
+	milestoneEntity "github.com/rl404/shimakaze/internal/domain/milestone/entity"
+	publisherEntity "github.com/rl404/shimakaze/internal/domain/publisher/entity"
 	vtuberEntity "github.com/rl404/shimakaze/internal/domain/vtuber/entity"
 	wikiaEntity "github.com/rl404/shimakaze/internal/domain/wikia/entity"
+	"github.com/rl404/shimakaze/internal/errors"
 	"github.com/rl404/shimakaze/internal/utils"
+	"github.com/rl404/shimakaze/pkg/breaker"
 )
 
 func (s *service) updateVtuber(ctx context.Context, id int64) (int, error) {
@@ -31,6 +38,11 @@ func (s *service) updateVtuber(ctx context.Context, id int64) (int, error) {
 			if code, err := s.nonVtuber.Create(ctx, id, ""); err != nil {
 				return code, stack.Wrap(ctx, err)
 			}
+
+			// Rebuild search index.
+			if code, err := s.search.SetStale(ctx); err != nil {
+				return code, stack.Wrap(ctx, err)
+			}
 			return http.StatusOK, nil
 		}
 		return code, stack.Wrap(ctx, err)
@@ -48,6 +60,11 @@ func (s *service) updateVtuber(ctx context.Context, id int64) (int, error) {
 			return code, stack.Wrap(ctx, err)
 		}
 
+		// Rebuild search index.
+		if code, err := s.search.SetStale(ctx); err != nil {
+			return code, stack.Wrap(ctx, err)
+		}
+
 		return http.StatusOK, nil
 	}
 
@@ -83,19 +100,67 @@ func (s *service) updateVtuber(ctx context.Context, id int64) (int, error) {
 	// Override values.
 	vtuber = s.overrideVtuberData(vtuber, existingVtuber)
 
+	// Generate normalized search keys.
+	vtuber.SearchKeys = s.getSearchKeys(vtuber)
+
 	// Fill channel data.
-	vtuber.Channels, vtuber.Subscriber, vtuber.MonthlySubscriber, vtuber.VideoCount, vtuber.AverageVideoLength, vtuber.TotalVideoLength = s.fillChannelData(ctx, vtuber.DebutDate, vtuber.RetirementDate, vtuber.Channels, existingVtuber)
+	channels, channelErrs := s.fillChannelData(ctx, vtuber.RetirementDate, vtuber.Channels, existingVtuber)
+	vtuber.Channels = channels
+
+	// Detect channel subscriber anomalies.
+	anomalies, code, err := s.getSubscriberAnomalies(ctx, vtuber.ID, vtuber.Channels)
+	if err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+	vtuber.Channels = s.excludeZeroAnomalies(vtuber.ID, vtuber.Channels, anomalies, existingVtuber)
+
+	// Summarize channel data.
+	vtuber.Subscriber, vtuber.MonthlySubscriber, vtuber.VideoCount, vtuber.AverageVideoLength, vtuber.TotalVideoLength = s.getChannelSummary(vtuber.DebutDate, vtuber.Channels)
+	vtuber.YoutubeSubscriber, vtuber.TwitchSubscriber, vtuber.BilibiliSubscriber, vtuber.NiconicoSubscriber, vtuber.TotalSubscriber = s.getPlatformSubscriber(vtuber.Channels)
+	vtuber.LastActivityDate = s.getLastActivityDate(vtuber.Channels, existingVtuber)
+
+	// Get channel subscriber growth.
+	channels, code, err = s.fillChannelGrowth(ctx, vtuber.ID, vtuber.Channels)
+	if err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+	vtuber.Channels = channels
 
 	// Update data.
 	if code, err := s.vtuber.UpdateByID(ctx, id, vtuber); err != nil {
 		return code, stack.Wrap(ctx, err)
 	}
 
+	// Rebuild search index if changed.
+	if s.isSearchIndexChanged(existingVtuber, vtuber) {
+		if code, err := s.search.SetStale(ctx); err != nil {
+			return code, stack.Wrap(ctx, err)
+		}
+	}
+
+	// Insert vtuber changes.
+	changes := s.getVtuberChanges(existingVtuber, vtuber)
+	if code, err := s.vtuberChange.Create(ctx, changes); err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	// Insert vtuber snapshot if new or changed.
+	if existingVtuber == nil || len(changes) > 0 {
+		if code, err := s.vtuber.CreateSnapshot(ctx, vtuber); err != nil {
+			return code, stack.Wrap(ctx, err)
+		}
+	}
+
 	// Insert channel stats history.
-	if code, err := s.createChannelStats(ctx, vtuber); err != nil {
+	if code, err := s.createChannelStats(ctx, vtuber, existingVtuber, anomalies); err != nil {
 		return code, stack.Wrap(ctx, err)
 	}
 
+	// Report failed channels after the other data is saved.
+	if code, err := s.joinChannelErrors(channelErrs); err != nil {
+		return code, stack.Wrap(ctx, err, errors.ErrChannelFetchFailed)
+	}
+
 	return http.StatusOK, nil
 }
 
@@ -281,31 +346,195 @@ func (s *service) mergeAgencies(a1, a2 []vtuberEntity.Agency) []vtuberEntity.Age
 	return a3
 }
 
-func (s *service) fillChannelData(ctx context.Context, debutDate, retirementDate *time.Time, channels []vtuberEntity.Channel, existingVtuber *vtuberEntity.Vtuber) ([]vtuberEntity.Channel, int, int, int, int, int) {
-	subscriber, monthlySubs, allVideoCount, avgVideoCount, totalVideoLength := 0, 0, 0, 0, 0
-	for i, channel := range channels {
-		switch channel.Type {
-		case vtuberEntity.ChannelYoutube:
-			channels[i] = s.fillYoutubeChannel(ctx, channels[i], existingVtuber)
-			channels[i] = s.fillYoutubeVideos(ctx, channels[i], retirementDate)
-		case vtuberEntity.ChannelTwitch:
-			channels[i] = s.fillTwitchChannel(ctx, channels[i])
-			channels[i] = s.fillTwitchVideo(ctx, channels[i], retirementDate)
-		case vtuberEntity.ChannelBilibili:
-			channels[i] = s.fillBilibiliChannel(ctx, channels[i])
-			channels[i] = s.fillBilibiliVideo(ctx, channels[i], retirementDate)
-		case vtuberEntity.ChannelNiconico:
-			channels[i] = s.fillNiconicoChannel(ctx, channels[i])
-			channels[i] = s.fillNiconicoVideo(ctx, channels[i], retirementDate)
+// channelWorker is the max number of channels
+// fetched at the same time.
+const channelWorker = 5
+
+type channelError struct {
+	index   int
+	channel vtuberEntity.Channel
+	code    int
+	err     error
+}
+
+func (s *service) fillChannelData(ctx context.Context, retirementDate *time.Time, channels []vtuberEntity.Channel, existingVtuber *vtuberEntity.Vtuber) ([]vtuberEntity.Channel, []channelError) {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []channelError
+
+	sem := make(chan struct{}, channelWorker)
+	for i := range channels {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int) {
+			defer func() { <-sem; wg.Done() }()
+
+			ctx, cancel := s.getWorkerContext(ctx)
+			defer cancel()
+
+			channel, code, err := s.fillChannel(ctx, channels[i], retirementDate, existingVtuber)
+			existingChannel := s.getExistingChannel(channels[i], existingVtuber)
+
+			switch {
+			case err == nil:
+				channel = s.setChannelHealth(channel, existingChannel, code, err)
+			case _errors.Is(err, breaker.ErrOpen) && existingChannel != nil:
+				// Platform is down, keep the stored data.
+				channel = *existingChannel
+				channel.IsStale = true
+			case existingChannel != nil:
+				// Half-filled channel should not replace
+				// the stored data, only the health is updated.
+				channel = s.setChannelHealth(*existingChannel, existingChannel, code, err)
+			default:
+				channel = s.setChannelHealth(channels[i], nil, code, err)
+			}
+
+			channels[i] = channel
+
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, channelError{index: i, channel: channel, code: code, err: err})
+				mu.Unlock()
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	return channels, errs
+}
+
+// getWorkerContext to get context for worker goroutine.
+// Error stack is not goroutine-safe and stack.Init reuses the
+// existing one, so the worker gets a new context which is
+// still canceled when the parent context is canceled.
+func (s *service) getWorkerContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	workerCtx, cancel := context.WithCancel(stack.Init(newrelic.NewContext(context.Background(), newrelic.FromContext(ctx).NewGoroutine())))
+	stop := context.AfterFunc(ctx, cancel)
+	return workerCtx, func() { stop(); cancel() }
+}
+
+func (s *service) fillChannel(ctx context.Context, channel vtuberEntity.Channel, retirementDate *time.Time, existingVtuber *vtuberEntity.Vtuber) (vtuberEntity.Channel, int, error) {
+	var code int
+	var err error
+
+	switch channel.Type {
+	case vtuberEntity.ChannelYoutube:
+		if channel, code, err = s.fillYoutubeChannel(ctx, channel, existingVtuber); err != nil {
+			return channel, code, stack.Wrap(ctx, err)
+		}
+		if channel, code, err = s.fillYoutubeVideos(ctx, channel, retirementDate); err != nil {
+			return channel, code, stack.Wrap(ctx, err)
+		}
+	case vtuberEntity.ChannelTwitch:
+		if channel, code, err = s.fillTwitchChannel(ctx, channel); err != nil {
+			return channel, code, stack.Wrap(ctx, err)
+		}
+		if channel, code, err = s.fillTwitchVideo(ctx, channel, retirementDate); err != nil {
+			return channel, code, stack.Wrap(ctx, err)
+		}
+	case vtuberEntity.ChannelBilibili:
+		if channel, code, err = s.fillBilibiliChannel(ctx, channel); err != nil {
+			return channel, code, stack.Wrap(ctx, err)
+		}
+		if channel, code, err = s.fillBilibiliVideo(ctx, channel, retirementDate); err != nil {
+			return channel, code, stack.Wrap(ctx, err)
+		}
+	case vtuberEntity.ChannelNiconico:
+		if channel, code, err = s.fillNiconicoChannel(ctx, channel); err != nil {
+			return channel, code, stack.Wrap(ctx, err)
+		}
+		if channel, code, err = s.fillNiconicoVideo(ctx, channel, retirementDate); err != nil {
+			return channel, code, stack.Wrap(ctx, err)
+		}
+	}
+
+	return channel, http.StatusOK, nil
+}
+
+func (s *service) getExistingChannel(channel vtuberEntity.Channel, existingVtuber *vtuberEntity.Vtuber) *vtuberEntity.Channel {
+	if existingVtuber == nil {
+		return nil
+	}
+
+	for _, existingChannel := range existingVtuber.Channels {
+		if existingChannel.Type == channel.Type && existingChannel.URL == channel.URL {
+			return &existingChannel
+		}
+	}
+
+	return nil
+}
+
+func (s *service) setChannelHealth(channel vtuberEntity.Channel, existingChannel *vtuberEntity.Channel, code int, err error) vtuberEntity.Channel {
+	if channel.Type == vtuberEntity.ChannelOther {
+		return channel
+	}
+
+	channel.IsStale = false
+
+	if existingChannel != nil {
+		channel.LastSuccessAt = existingChannel.LastSuccessAt
+		channel.LastError = existingChannel.LastError
+		channel.LastErrorCode = existingChannel.LastErrorCode
+		channel.FailCount = existingChannel.FailCount
+	}
+
+	if err != nil {
+		channel.LastError = err.Error()
+		channel.LastErrorCode = code
+		channel.FailCount++
+		return channel
+	}
+
+	now := time.Now()
+	channel.LastSuccessAt = &now
+	channel.FailCount = 0
+
+	return channel
+}
+
+// joinChannelErrors to join failed channel errors in
+// channel order. The code is internal server error if
+// the channels failed with different codes.
+func (s *service) joinChannelErrors(errs []channelError) (int, error) {
+	if len(errs) == 0 {
+		return http.StatusOK, nil
+	}
+
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].index < errs[j].index
+	})
+
+	code := errs[0].code
+	joined := make([]error, len(errs))
+	for i, e := range errs {
+		if e.code != code {
+			code = http.StatusInternalServerError
 		}
+		joined[i] = fmt.Errorf("%s %s: %w", e.channel.Type, e.channel.URL, e.err)
+	}
 
-		if channels[i].Subscriber > subscriber {
-			subscriber = channels[i].Subscriber
+	return code, _errors.Join(joined...)
+}
+
+func (s *service) getChannelSummary(debutDate *time.Time, channels []vtuberEntity.Channel) (int, int, int, int, int) {
+	subscriber, monthlySubs, allVideoCount, avgVideoCount, totalVideoLength := 0, 0, 0, 0, 0
+	for _, channel := range channels {
+		if channel.Subscriber > subscriber {
+			subscriber = channel.Subscriber
 		}
 
-		allVideoCount += len(channels[i].Videos)
+		for _, video := range channel.Videos {
+			// Twitch schedule segments are not videos yet.
+			if video.IsScheduled {
+				continue
+			}
+
+			allVideoCount++
 
-		for _, video := range channels[i].Videos {
 			if video.StartDate == nil || video.EndDate == nil {
 				continue
 			}
@@ -326,70 +555,109 @@ func (s *service) fillChannelData(ctx context.Context, debutDate, retirementDate
 		avgVideoLength = int(float64(totalVideoLength) / float64(avgVideoCount))
 	}
 
-	return channels, subscriber, monthlySubs, allVideoCount, avgVideoLength, totalVideoLength
+	return subscriber, monthlySubs, allVideoCount, avgVideoLength, totalVideoLength
 }
 
-func (s *service) fillYoutubeChannel(ctx context.Context, channel vtuberEntity.Channel, existingVtuber *vtuberEntity.Vtuber) vtuberEntity.Channel {
-	// Find existing channel.
+// getLastActivityDate to get the latest video or stream
+// start date of all channels. Old videos are not kept, so
+// the existing date is used if it is later.
+func (s *service) getLastActivityDate(channels []vtuberEntity.Channel, existingVtuber *vtuberEntity.Vtuber) *time.Time {
+	var lastDate *time.Time
 	if existingVtuber != nil {
-		for _, existingChannel := range existingVtuber.Channels {
-			if existingChannel.Type == channel.Type && existingChannel.URL == channel.URL {
-				channel.ID = existingChannel.ID
+		lastDate = existingVtuber.LastActivityDate
+	}
+
+	now := time.Now()
+	for _, channel := range channels {
+		for _, video := range channel.Videos {
+			if video.StartDate == nil || video.StartDate.After(now) {
+				continue
+			}
+
+			if lastDate == nil || video.StartDate.After(*lastDate) {
+				lastDate = video.StartDate
 			}
 		}
 	}
 
+	return lastDate
+}
+
+// getPlatformSubscriber to get subscriber sum of each
+// platform channels and the total of all platforms.
+func (s *service) getPlatformSubscriber(channels []vtuberEntity.Channel) (int, int, int, int, int) {
+	youtube, twitch, bilibili, niconico := 0, 0, 0, 0
+	for _, channel := range channels {
+		switch channel.Type {
+		case vtuberEntity.ChannelYoutube:
+			youtube += channel.Subscriber
+		case vtuberEntity.ChannelTwitch:
+			twitch += channel.Subscriber
+		case vtuberEntity.ChannelBilibili:
+			bilibili += channel.Subscriber
+		case vtuberEntity.ChannelNiconico:
+			niconico += channel.Subscriber
+		}
+	}
+	return youtube, twitch, bilibili, niconico, youtube + twitch + bilibili + niconico
+}
+
+func (s *service) fillYoutubeChannel(ctx context.Context, channel vtuberEntity.Channel, existingVtuber *vtuberEntity.Vtuber) (vtuberEntity.Channel, int, error) {
+	// Find existing channel.
+	if existingChannel := s.getExistingChannel(channel, existingVtuber); existingChannel != nil {
+		channel.ID = existingChannel.ID
+	}
+
 	// Use existing id.
 	if channel.ID != "" {
-		ch, _, err := s.youtube.GetChannelByID(ctx, channel.ID)
+		ch, code, err := s.youtube.GetChannelByID(ctx, channel.ID)
 		if err != nil {
-			stack.Wrap(ctx, err)
-			return channel
+			return channel, code, stack.Wrap(ctx, err)
 		}
 
 		channel.ID = ch.ID
 		channel.Name = ch.Name
 		channel.Image = ch.Image
 		channel.Subscriber = ch.Subscriber
+		channel.ViewCount = ch.ViewCount
+		channel.VideoCount = ch.VideoCount
 
-		return channel
+		return channel, http.StatusOK, nil
 	}
 
-	channelID, _, err := s.youtube.GetChannelIDByURL(ctx, channel.URL)
+	channelID, code, err := s.youtube.GetChannelIDByURL(ctx, channel.URL)
 	if err != nil {
-		stack.Wrap(ctx, err)
-		return channel
+		return channel, code, stack.Wrap(ctx, err)
 	}
 
-	ch, _, err := s.youtube.GetChannelByID(ctx, channelID)
+	ch, code, err := s.youtube.GetChannelByID(ctx, channelID)
 	if err != nil {
-		stack.Wrap(ctx, err)
-		return channel
+		return channel, code, stack.Wrap(ctx, err)
 	}
 
 	channel.ID = ch.ID
 	channel.Name = ch.Name
 	channel.Image = ch.Image
 	channel.Subscriber = ch.Subscriber
+	channel.ViewCount = ch.ViewCount
+	channel.VideoCount = ch.VideoCount
 
-	return channel
+	return channel, http.StatusOK, nil
 }
 
-func (s *service) fillYoutubeVideos(ctx context.Context, channel vtuberEntity.Channel, retirementDate *time.Time) vtuberEntity.Channel {
+func (s *service) fillYoutubeVideos(ctx context.Context, channel vtuberEntity.Channel, retirementDate *time.Time) (vtuberEntity.Channel, int, error) {
 	if channel.ID == "" || (retirementDate != nil && retirementDate.Before(time.Now())) {
-		return channel
+		return channel, http.StatusOK, nil
 	}
 
-	videoIDs, _, err := s.youtube.GetVideoIDsByChannelID(ctx, channel.ID)
+	videoIDs, code, err := s.youtube.GetVideoIDsByChannelID(ctx, channel.ID)
 	if err != nil {
-		stack.Wrap(ctx, err)
-		return channel
+		return channel, code, stack.Wrap(ctx, err)
 	}
 
-	videos, _, err := s.youtube.GetVideosByIDs(ctx, videoIDs)
+	videos, code, err := s.youtube.GetVideosByIDs(ctx, videoIDs)
 	if err != nil {
-		stack.Wrap(ctx, err)
-		return channel
+		return channel, code, stack.Wrap(ctx, err)
 	}
 
 	res := make([]vtuberEntity.Video, len(videos))
@@ -406,51 +674,47 @@ func (s *service) fillYoutubeVideos(ctx context.Context, channel vtuberEntity.Ch
 
 	channel.Videos = res
 
-	return channel
+	return channel, http.StatusOK, nil
 }
 
-func (s *service) fillTwitchChannel(ctx context.Context, channel vtuberEntity.Channel) vtuberEntity.Channel {
+func (s *service) fillTwitchChannel(ctx context.Context, channel vtuberEntity.Channel) (vtuberEntity.Channel, int, error) {
 	username := utils.GetLastPathFromURL(channel.URL)
 	if username == "" {
-		return channel
+		return channel, http.StatusOK, nil
 	}
 
-	user, _, err := s.twitch.GetUser(ctx, username)
+	user, code, err := s.twitch.GetUser(ctx, username)
 	if err != nil {
-		stack.Wrap(ctx, err)
-		return channel
+		return channel, code, stack.Wrap(ctx, err)
 	}
 
 	channel.ID = user.ID
 	channel.Name = user.Name
 	channel.Image = user.Image
 
-	follower, _, err := s.twitch.GetFollowerCount(ctx, user.ID)
+	follower, code, err := s.twitch.GetFollowerCount(ctx, user.ID)
 	if err != nil {
-		stack.Wrap(ctx, err)
-		return channel
+		return channel, code, stack.Wrap(ctx, err)
 	}
 
 	channel.Subscriber = follower
 
-	return channel
+	return channel, http.StatusOK, nil
 }
 
-func (s *service) fillTwitchVideo(ctx context.Context, channel vtuberEntity.Channel, retirementDate *time.Time) vtuberEntity.Channel {
+func (s *service) fillTwitchVideo(ctx context.Context, channel vtuberEntity.Channel, retirementDate *time.Time) (vtuberEntity.Channel, int, error) {
 	if channel.ID == "" || (retirementDate != nil && retirementDate.Before(time.Now())) {
-		return channel
+		return channel, http.StatusOK, nil
 	}
 
-	videos, _, err := s.twitch.GetVideos(ctx, channel.ID)
+	videos, code, err := s.twitch.GetVideos(ctx, channel.ID)
 	if err != nil {
-		stack.Wrap(ctx, err)
-		return channel
+		return channel, code, stack.Wrap(ctx, err)
 	}
 
-	stream, _, err := s.twitch.GetLiveStream(ctx, channel.ID)
+	stream, code, err := s.twitch.GetLiveStream(ctx, channel.ID)
 	if err != nil {
-		stack.Wrap(ctx, err)
-		return channel
+		return channel, code, stack.Wrap(ctx, err)
 	}
 
 	res := make([]vtuberEntity.Video, len(videos))
@@ -471,14 +735,50 @@ func (s *service) fillTwitchVideo(ctx context.Context, channel vtuberEntity.Chan
 	}
 
 	channel.Videos = res
+	channel.IsLive = stream != nil
 
-	return channel
+	upcomingVideos, code, err := s.getTwitchUpcomingVideos(ctx, channel)
+	if err != nil {
+		return channel, code, stack.Wrap(ctx, err)
+	}
+
+	channel.Videos = append(channel.Videos, upcomingVideos...)
+
+	return channel, http.StatusOK, nil
+}
+
+func (s *service) getTwitchUpcomingVideos(ctx context.Context, channel vtuberEntity.Channel) ([]vtuberEntity.Video, int, error) {
+	segments, code, err := s.twitch.GetSchedule(ctx, channel.ID)
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	var res []vtuberEntity.Video
+	for _, segment := range segments {
+		if segment.StartDate == nil || segment.StartDate.Before(time.Now()) {
+			continue
+		}
+
+		res = append(res, vtuberEntity.Video{
+			ID:               segment.ID,
+			Title:            segment.Title,
+			URL:              channel.URL,
+			Image:            channel.Image,
+			StartDate:        segment.StartDate,
+			ScheduledEndDate: segment.EndDate,
+			Category:         segment.Category,
+			IsRecurring:      segment.IsRecurring,
+			IsScheduled:      true,
+		})
+	}
+
+	return res, http.StatusOK, nil
 }
 
-func (s *service) fillBilibiliChannel(ctx context.Context, channel vtuberEntity.Channel) vtuberEntity.Channel {
+func (s *service) fillBilibiliChannel(ctx context.Context, channel vtuberEntity.Channel) (vtuberEntity.Channel, int, error) {
 	userID := utils.GetLastPathFromURL(channel.URL)
 	if userID == "" {
-		return channel
+		return channel, http.StatusOK, nil
 	}
 
 	// user, _, err := s.bilibili.GetUser(ctx, userID)
@@ -491,27 +791,25 @@ func (s *service) fillBilibiliChannel(ctx context.Context, channel vtuberEntity.
 	// channel.Name = user.Name
 	// channel.Image = user.Image
 
-	follower, _, err := s.bilibili.GetFollowerCount(ctx, userID)
+	follower, code, err := s.bilibili.GetFollowerCount(ctx, userID)
 	if err != nil {
-		stack.Wrap(ctx, err)
-		return channel
+		return channel, code, stack.Wrap(ctx, err)
 	}
 
 	channel.Subscriber = follower
 
-	return channel
+	return channel, http.StatusOK, nil
 }
 
-func (s *service) fillBilibiliVideo(ctx context.Context, channel vtuberEntity.Channel, retirementDate *time.Time) vtuberEntity.Channel {
+func (s *service) fillBilibiliVideo(ctx context.Context, channel vtuberEntity.Channel, retirementDate *time.Time) (vtuberEntity.Channel, int, error) {
 	// todo: fix get bilibili videos.
 	if true || channel.ID == "" || (retirementDate != nil && retirementDate.Before(time.Now())) {
-		return channel
+		return channel, http.StatusOK, nil
 	}
 
-	videos, _, err := s.bilibili.GetVideos(ctx, channel.ID)
+	videos, code, err := s.bilibili.GetVideos(ctx, channel.ID)
 	if err != nil {
-		stack.Wrap(ctx, err)
-		return channel
+		return channel, code, stack.Wrap(ctx, err)
 	}
 
 	res := make([]vtuberEntity.Video, len(videos))
@@ -528,18 +826,17 @@ func (s *service) fillBilibiliVideo(ctx context.Context, channel vtuberEntity.Ch
 
 	channel.Videos = res
 
-	return channel
+	return channel, http.StatusOK, nil
 }
 
-func (s *service) fillNiconicoChannel(ctx context.Context, channel vtuberEntity.Channel) vtuberEntity.Channel {
+func (s *service) fillNiconicoChannel(ctx context.Context, channel vtuberEntity.Channel) (vtuberEntity.Channel, int, error) {
 	if channel.URL == "" {
-		return channel
+		return channel, http.StatusOK, nil
 	}
 
-	user, _, err := s.niconico.GetUser(ctx, channel.URL)
+	user, code, err := s.niconico.GetUser(ctx, channel.URL)
 	if err != nil {
-		stack.Wrap(ctx, err)
-		return channel
+		return channel, code, stack.Wrap(ctx, err)
 	}
 
 	channel.ID = user.ID
@@ -547,18 +844,17 @@ func (s *service) fillNiconicoChannel(ctx context.Context, channel vtuberEntity.
 	channel.Image = user.Image
 	channel.Subscriber = user.Subscriber
 
-	return channel
+	return channel, http.StatusOK, nil
 }
 
-func (s *service) fillNiconicoVideo(ctx context.Context, channel vtuberEntity.Channel, retirementDate *time.Time) vtuberEntity.Channel {
+func (s *service) fillNiconicoVideo(ctx context.Context, channel vtuberEntity.Channel, retirementDate *time.Time) (vtuberEntity.Channel, int, error) {
 	if channel.ID == "" || (retirementDate != nil && retirementDate.Before(time.Now())) {
-		return channel
+		return channel, http.StatusOK, nil
 	}
 
-	videos, _, err := s.niconico.GetVideos(ctx, channel.ID)
+	videos, code, err := s.niconico.GetVideos(ctx, channel.ID)
 	if err != nil {
-		stack.Wrap(ctx, err)
-		return channel
+		return channel, code, stack.Wrap(ctx, err)
 	}
 
 	res := make([]vtuberEntity.Video, 0)
@@ -573,10 +869,9 @@ func (s *service) fillNiconicoVideo(ctx context.Context, channel vtuberEntity.Ch
 		})
 	}
 
-	broadcasts, _, err := s.niconico.GetBroadcasts(ctx, channel.ID)
+	broadcasts, code, err := s.niconico.GetBroadcasts(ctx, channel.ID)
 	if err != nil {
-		stack.Wrap(ctx, err)
-		return channel
+		return channel, code, stack.Wrap(ctx, err)
 	}
 
 	for _, v := range broadcasts {
@@ -599,19 +894,205 @@ func (s *service) fillNiconicoVideo(ctx context.Context, channel vtuberEntity.Ch
 
 	channel.Videos = res
 
-	return channel
+	return channel, http.StatusOK, nil
 }
 
-func (s *service) createChannelStats(ctx context.Context, vtuber vtuberEntity.Vtuber) (int, error) {
+func (s *service) createChannelStats(ctx context.Context, vtuber vtuberEntity.Vtuber, existingVtuber *vtuberEntity.Vtuber, anomalies map[channelStatsEntity.ChannelStats]int) (int, error) {
 	for _, channel := range vtuber.Channels {
-		if code, err := s.channelStatsHistory.Create(ctx, channelStatsEntity.ChannelStats{
-			VtuberID:    vtuber.ID,
-			ChannelID:   channel.ID,
-			ChannelType: channel.Type,
-			Subscriber:  channel.Subscriber,
+		if channel.IsStale {
+			continue
+		}
+
+		existingChannel := s.getExistingChannel(channel, existingVtuber)
+
+		isAnomaly, code, err := s.createChannelStat(ctx, vtuber.ID, channel, existingChannel, anomalies)
+		if err != nil {
+			return code, stack.Wrap(ctx, err)
+		}
+
+		if isAnomaly {
+			continue
+		}
+
+		var oldSubscriber int
+		if existingChannel != nil {
+			oldSubscriber = existingChannel.Subscriber
+		}
+
+		if code, err := s.createChannelMilestones(ctx, vtuber, channel, oldSubscriber); err != nil {
+			return code, stack.Wrap(ctx, err)
+		}
+	}
+	return http.StatusOK, nil
+}
+
+// createChannelStat to insert channel stats history.
+// Anomaly subscriber is recorded as is but flagged.
+func (s *service) createChannelStat(ctx context.Context, vtuberID int64, channel vtuberEntity.Channel, existingChannel *vtuberEntity.Channel, anomalies map[channelStatsEntity.ChannelStats]int) (bool, int, error) {
+	subscriber, isAnomaly := anomalies[channelStatsEntity.ChannelStats{
+		VtuberID:    vtuberID,
+		ChannelID:   channel.ID,
+		ChannelType: channel.Type,
+	}]
+	if !isAnomaly {
+		subscriber = channel.Subscriber
+	}
+
+	var since *time.Time
+	if existingChannel != nil {
+		since = existingChannel.LastSuccessAt
+	}
+
+	if code, err := s.channelStatsHistory.Create(ctx, channelStatsEntity.ChannelStats{
+		VtuberID:       vtuberID,
+		ChannelID:      channel.ID,
+		ChannelType:    channel.Type,
+		Subscriber:     subscriber,
+		ViewCount:      channel.ViewCount,
+		VideoCount:     channel.VideoCount,
+		StreamDuration: s.getStreamDuration(channel.Videos, since),
+		IsAnomaly:      isAnomaly,
+	}); err != nil {
+		return isAnomaly, code, stack.Wrap(ctx, err)
+	}
+
+	return isAnomaly, http.StatusOK, nil
+}
+
+// createChannelMilestones to save crossed channel milestones
+// and publish them. Milestone which failed to be published
+// is kept unpublished and retried on the next update.
+func (s *service) createChannelMilestones(ctx context.Context, vtuber vtuberEntity.Vtuber, channel vtuberEntity.Channel, oldSubscriber int) (int, error) {
+	if code, err := s.milestone.CreateCrossed(ctx, milestoneEntity.CreateCrossedRequest{
+		VtuberID:      vtuber.ID,
+		VtuberName:    vtuber.Name,
+		VtuberImage:   vtuber.Image,
+		ChannelID:     channel.ID,
+		ChannelName:   channel.Name,
+		ChannelType:   channel.Type,
+		OldSubscriber: oldSubscriber,
+		NewSubscriber: channel.Subscriber,
+	}); err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	milestones, code, err := s.milestone.GetUnpublished(ctx, milestoneEntity.GetUnpublishedRequest{
+		VtuberID:    vtuber.ID,
+		ChannelID:   channel.ID,
+		ChannelType: channel.Type,
+	})
+	if err != nil {
+		return code, stack.Wrap(ctx, err)
+	}
+
+	for _, m := range milestones {
+		if err := s.publisher.PublishMilestone(ctx, publisherEntity.MilestoneMessage{
+			VtuberID:    m.VtuberID,
+			VtuberName:  m.VtuberName,
+			VtuberImage: m.VtuberImage,
+			ChannelID:   m.ChannelID,
+			ChannelName: m.ChannelName,
+			ChannelType: string(m.ChannelType),
+			Milestone:   m.Milestone,
+			Subscriber:  m.Subscriber,
+			ReachedAt:   m.ReachedAt,
 		}); err != nil {
+			return http.StatusInternalServerError, stack.Wrap(ctx, err)
+		}
+
+		if code, err := s.milestone.UpdatePublished(ctx, m); err != nil {
 			return code, stack.Wrap(ctx, err)
 		}
 	}
+
 	return http.StatusOK, nil
 }
+
+// getStreamDuration to get total duration (in seconds)
+// of videos ended after the time.
+func (s *service) getStreamDuration(videos []vtuberEntity.Video, since *time.Time) int {
+	if since == nil {
+		return 0
+	}
+
+	var duration int
+	for _, video := range videos {
+		if video.StartDate == nil || video.EndDate == nil || !video.EndDate.After(*since) {
+			continue
+		}
+		duration += int(video.EndDate.Sub(*video.StartDate).Seconds())
+	}
+
+	return duration
+}
+
+func (s *service) fillChannelGrowth(ctx context.Context, vtuberID int64, channels []vtuberEntity.Channel) ([]vtuberEntity.Channel, int, error) {
+	now := time.Now()
+
+	day7, code, err := s.getChannelSubscriberMap(ctx, vtuberID, now.AddDate(0, 0, -7))
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	day30, code, err := s.getChannelSubscriberMap(ctx, vtuberID, now.AddDate(0, 0, -30))
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	day365, code, err := s.getChannelSubscriberMap(ctx, vtuberID, now.AddDate(0, 0, -365))
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	for i, channel := range channels {
+		key := channelStatsEntity.ChannelStats{
+			VtuberID:    vtuberID,
+			ChannelID:   channel.ID,
+			ChannelType: channel.Type,
+		}
+
+		channels[i].Growth = vtuberEntity.ChannelGrowth{
+			Day7:   s.getGrowth(channel.Subscriber, day7[key]),
+			Day30:  s.getGrowth(channel.Subscriber, day30[key]),
+			Day365: s.getGrowth(channel.Subscriber, day365[key]),
+		}
+	}
+
+	return channels, http.StatusOK, nil
+}
+
+func (s *service) getChannelSubscriberMap(ctx context.Context, vtuberID int64, asOf time.Time) (map[channelStatsEntity.ChannelStats]int, int, error) {
+	histories, code, err := s.channelStatsHistory.GetLatest(ctx, channelStatsEntity.GetLatestRequest{
+		VtuberIDs: []int64{vtuberID},
+		AsOf:      asOf,
+	})
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
+	}
+
+	subscriberMap := make(map[channelStatsEntity.ChannelStats]int)
+	for _, history := range histories {
+		subscriberMap[channelStatsEntity.ChannelStats{
+			VtuberID:    history.VtuberID,
+			ChannelID:   history.ChannelID,
+			ChannelType: history.ChannelType,
+		}] = history.Subscriber
+	}
+
+	return subscriberMap, http.StatusOK, nil
+}
+
+// getGrowth to get subscriber growth compared to old subscriber.
+// Empty if there is no old subscriber to compare.
+func (s *service) getGrowth(subscriber, oldSubscriber int) vtuberEntity.Growth {
+	if oldSubscriber <= 0 {
+		return vtuberEntity.Growth{}
+	}
+
+	diff := subscriber - oldSubscriber
+
+	return vtuberEntity.Growth{
+		Subscriber: diff,
+		Percent:    math.Round(float64(diff)/float64(oldSubscriber)*10000) / 100,
+	}
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	vtuberEntity "github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+)
+
+func TestGetChannelSummary(t *testing.T) {
+	date := func(d int) *time.Time {
+		t := time.Now().AddDate(0, 0, d)
+		return &t
+	}
+
+	channels := []vtuberEntity.Channel{
+		{
+			Type:       vtuberEntity.ChannelYoutube,
+			Subscriber: 1000,
+			Videos: []vtuberEntity.Video{
+				{ID: "done", StartDate: date(-2), EndDate: date(-1)},
+				{ID: "live", StartDate: date(-1)},
+				{ID: "premiere", StartDate: date(1)},
+			},
+		},
+		{
+			Type:       vtuberEntity.ChannelTwitch,
+			Subscriber: 500,
+			Videos: []vtuberEntity.Video{
+				{ID: "vod", StartDate: date(-3), EndDate: date(-2)},
+				{ID: "schedule", StartDate: date(1), ScheduledEndDate: date(2), IsScheduled: true},
+			},
+		},
+	}
+
+	subscriber, _, videoCount, avgLength, totalLength := (&service{}).getChannelSummary(nil, channels)
+
+	if subscriber != 1000 {
+		t.Errorf("subscriber = %d, want 1000", subscriber)
+	}
+
+	// Youtube upcoming premiere is still counted.
+	if videoCount != 4 {
+		t.Errorf("video count = %d, want 4", videoCount)
+	}
+
+	day := int((24 * time.Hour).Seconds())
+	if totalLength != 2*day {
+		t.Errorf("total length = %d, want %d", totalLength, 2*day)
+	}
+
+	if avgLength != day {
+		t.Errorf("average length = %d, want %d", avgLength, day)
+	}
+}
+
+func TestJoinChannelErrors(t *testing.T) {
+	errYoutube := errors.New("youtube")
+	errTwitch := errors.New("twitch")
+
+	youtube := vtuberEntity.Channel{Type: vtuberEntity.ChannelYoutube, URL: "yt"}
+	twitch := vtuberEntity.Channel{Type: vtuberEntity.ChannelTwitch, URL: "tw"}
+
+	tests := []struct {
+		name     string
+		errs     []channelError
+		wantCode int
+		wantErr  string
+	}{
+		{
+			name:     "no-error",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "single",
+			errs:     []channelError{{index: 0, channel: youtube, code: http.StatusNotFound, err: errYoutube}},
+			wantCode: http.StatusNotFound,
+			wantErr:  "YOUTUBE yt: youtube",
+		},
+		{
+			name: "same-code-in-channel-order",
+			errs: []channelError{
+				{index: 1, channel: twitch, code: http.StatusBadGateway, err: errTwitch},
+				{index: 0, channel: youtube, code: http.StatusBadGateway, err: errYoutube},
+			},
+			wantCode: http.StatusBadGateway,
+			wantErr:  "YOUTUBE yt: youtube\nTWITCH tw: twitch",
+		},
+		{
+			name: "different-code",
+			errs: []channelError{
+				{index: 0, channel: youtube, code: http.StatusNotFound, err: errYoutube},
+				{index: 1, channel: twitch, code: http.StatusBadGateway, err: errTwitch},
+			},
+			wantCode: http.StatusInternalServerError,
+			wantErr:  "YOUTUBE yt: youtube\nTWITCH tw: twitch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := (&service{}).joinChannelErrors(tt.errs)
+			if code != tt.wantCode {
+				t.Fatalf("code = %d, want %d", code, tt.wantCode)
+			}
+
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("err = %v, want nil", err)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("err = %v, want %q", err, tt.wantErr)
+			}
+
+			for _, e := range tt.errs {
+				if !errors.Is(err, e.err) {
+					t.Fatalf("err does not wrap %v", e.err)
+				}
+			}
+		})
+	}
+}
@@ -12,19 +12,22 @@ import (
 )
 
 type video struct {
-	VtuberID       int64              `json:"vtuber_id"`
-	VtuberName     string             `json:"vtuber_name"`
-	VtuberImage    string             `json:"vtuber_image"`
-	ChannelID      string             `json:"channel_id"`
-	ChannelName    string             `json:"channel_name"`
-	ChannelType    entity.ChannelType `json:"channel_type"`
-	ChannelURL     string             `json:"channel_url"`
-	VideoID        string             `json:"video_id"`
-	VideoTitle     string             `json:"video_title"`
-	VideoURL       string             `json:"video_url"`
-	VideoImage     string             `json:"video_image"`
-	VideoStartDate *time.Time         `json:"video_start_date"`
-	VideoEndDate   *time.Time         `json:"video_end_date"`
+	VtuberID              int64              `json:"vtuber_id"`
+	VtuberName            string             `json:"vtuber_name"`
+	VtuberImage           string             `json:"vtuber_image"`
+	ChannelID             string             `json:"channel_id"`
+	ChannelName           string             `json:"channel_name"`
+	ChannelType           entity.ChannelType `json:"channel_type"`
+	ChannelURL            string             `json:"channel_url"`
+	VideoID               string             `json:"video_id"`
+	VideoTitle            string             `json:"video_title"`
+	VideoURL              string             `json:"video_url"`
+	VideoImage            string             `json:"video_image"`
+	VideoStartDate        *time.Time         `json:"video_start_date"`
+	VideoEndDate          *time.Time         `json:"video_end_date"`
+	VideoScheduledEndDate *time.Time         `json:"video_scheduled_end_date"`
+	VideoCategory         string             `json:"video_category"`
+	VideoIsRecurring      bool               `json:"video_is_recurring"`
 }
 
 // GetVideosRequest is get videos request model.
@@ -35,6 +38,7 @@ type GetVideosRequest struct {
 	Sort       string `validate:"oneof=video_start_date -video_start_date" mod:"default=-video_start_date,trim,lcase"`
 	Page       int    `validate:"required,gte=1" mod:"default=1"`
 	Limit      int    `validate:"required,gte=-1" mod:"default=20"`
+	Cursor     string `mod:"trim"`
 }
 
 // GetVideos to get video list.
@@ -61,13 +65,14 @@ func (s *service) GetVideos(ctx context.Context, data GetVideosRequest) ([]video
 		endDate = &tmp
 	}
 
-	videos, total, code, err := s.vtuber.GetVideos(ctx, entity.GetVideosRequest{
+	videos, total, nextCursor, code, err := s.vtuber.GetVideos(ctx, entity.GetVideosRequest{
 		StartDate:  startDate,
 		EndDate:    endDate,
 		IsFinished: data.IsFinished,
 		Sort:       data.Sort,
 		Page:       data.Page,
 		Limit:      data.Limit,
+		Cursor:     data.Cursor,
 	})
 	if err != nil {
 		return nil, nil, code, stack.Wrap(ctx, err)
@@ -76,25 +81,29 @@ func (s *service) GetVideos(ctx context.Context, data GetVideosRequest) ([]video
 	res := make([]video, len(videos))
 	for i, v := range videos {
 		res[i] = video{
-			VtuberID:       v.VtuberID,
-			VtuberName:     v.VtuberName,
-			VtuberImage:    v.VtuberImage,
-			ChannelID:      v.ChannelID,
-			ChannelName:    v.ChannelName,
-			ChannelType:    v.ChannelType,
-			ChannelURL:     v.ChannelURL,
-			VideoID:        v.VideoID,
-			VideoTitle:     v.VideoTitle,
-			VideoURL:       v.VideoURL,
-			VideoImage:     v.VideoImage,
-			VideoStartDate: v.VideoStartDate,
-			VideoEndDate:   v.VideoEndDate,
+			VtuberID:              v.VtuberID,
+			VtuberName:            v.VtuberName,
+			VtuberImage:           v.VtuberImage,
+			ChannelID:             v.ChannelID,
+			ChannelName:           v.ChannelName,
+			ChannelType:           v.ChannelType,
+			ChannelURL:            v.ChannelURL,
+			VideoID:               v.VideoID,
+			VideoTitle:            v.VideoTitle,
+			VideoURL:              v.VideoURL,
+			VideoImage:            v.VideoImage,
+			VideoStartDate:        v.VideoStartDate,
+			VideoEndDate:          v.VideoEndDate,
+			VideoScheduledEndDate: v.VideoScheduledEndDate,
+			VideoCategory:         v.VideoCategory,
+			VideoIsRecurring:      v.VideoIsRecurring,
 		}
 	}
 
 	return res, &pagination{
-		Page:  data.Page,
-		Limit: data.Limit,
-		Total: total,
+		Page:       data.Page,
+		Limit:      data.Limit,
+		Total:      total,
+		NextCursor: nextCursor,
 	}, http.StatusOK, nil
 }
@@ -11,43 +11,54 @@ import (
 	agencyEntity "github.com/rl404/shimakaze/internal/domain/agency/entity"
 	historyEntity "github.com/rl404/shimakaze/internal/domain/channel_stats_history/entity"
 	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/errors"
 	"github.com/rl404/shimakaze/internal/utils"
 )
 
 type vtuber struct {
-	ID                  int64            `json:"id"`
-	Name                string           `json:"name"`
-	Image               string           `json:"image"`
-	OriginalNames       []string         `json:"original_names"`
-	Nicknames           []string         `json:"nicknames"`
-	Caption             string           `json:"caption"`
-	DebutDate           *time.Time       `json:"debut_date"`
-	RetirementDate      *time.Time       `json:"retirement_date"`
-	Has2D               bool             `json:"has_2d"`
-	Has3D               bool             `json:"has_3d"`
-	CharacterDesigners  []string         `json:"character_designers"`
-	Character2DModelers []string         `json:"character_2d_modelers"`
-	Character3DModelers []string         `json:"character_3d_modelers"`
-	Agencies            []vtuberAgency   `json:"agencies"`
-	Affiliations        []string         `json:"affiliations"`
-	Languages           []vtuberLanguage `json:"languages"`
-	Channels            []vtuberChannel  `json:"channels"`
-	Subscriber          int              `json:"subscriber"`
-	MonthlySubscriber   int              `json:"monthly_subscriber"`
-	VideoCount          int              `json:"video_count"`
-	AverageVideoLength  int              `json:"average_video_length"`
-	TotalVideoLength    int              `json:"total_video_length"`
-	SocialMedias        []string         `json:"social_medias"`
-	OfficialWebsites    []string         `json:"official_websites"`
-	Gender              string           `json:"gender"`
-	Age                 *float64         `json:"age"`
-	Birthday            *time.Time       `json:"birthday"`
-	Height              *float64         `json:"height"`
-	Weight              *float64         `json:"weight"`
-	BloodType           string           `json:"blood_type"`
-	ZodiacSign          string           `json:"zodiac_sign"`
-	Emoji               string           `json:"emoji"`
-	UpdatedAt           time.Time        `json:"updated_at"`
+	ID                  int64                 `json:"id"`
+	Name                string                `json:"name"`
+	Image               string                `json:"image"`
+	OriginalNames       []string              `json:"original_names"`
+	Nicknames           []string              `json:"nicknames"`
+	Caption             string                `json:"caption"`
+	DebutDate           *time.Time            `json:"debut_date"`
+	RetirementDate      *time.Time            `json:"retirement_date"`
+	Has2D               bool                  `json:"has_2d"`
+	Has3D               bool                  `json:"has_3d"`
+	CharacterDesigners  []string              `json:"character_designers"`
+	Character2DModelers []string              `json:"character_2d_modelers"`
+	Character3DModelers []string              `json:"character_3d_modelers"`
+	Agencies            []vtuberAgency        `json:"agencies"`
+	Affiliations        []string              `json:"affiliations"`
+	Languages           []vtuberLanguage      `json:"languages"`
+	Channels            []vtuberChannel       `json:"channels"`
+	Subscriber          int                   `json:"subscriber"`
+	YoutubeSubscriber   int                   `json:"youtube_subscriber"`
+	TwitchSubscriber    int                   `json:"twitch_subscriber"`
+	BilibiliSubscriber  int                   `json:"bilibili_subscriber"`
+	NiconicoSubscriber  int                   `json:"niconico_subscriber"`
+	TotalSubscriber     int                   `json:"total_subscriber"`
+	MonthlySubscriber   int                   `json:"monthly_subscriber"`
+	VideoCount          int                   `json:"video_count"`
+	AverageVideoLength  int                   `json:"average_video_length"`
+	TotalVideoLength    int                   `json:"total_video_length"`
+	LastActivityDate    *time.Time            `json:"last_activity_date"`
+	ActivityStatus      entity.ActivityStatus `json:"activity_status"`
+	SocialMedias        []string              `json:"social_medias"`
+	OfficialWebsites    []string              `json:"official_websites"`
+	Gender              string                `json:"gender"`
+	Age                 *float64              `json:"age"`
+	Birthday            *time.Time            `json:"birthday"`
+	Height              *float64              `json:"height"`
+	Weight              *float64              `json:"weight"`
+	BloodType           string                `json:"blood_type"`
+	ZodiacSign          string                `json:"zodiac_sign"`
+	Emoji               string                `json:"emoji"`
+	UpdatedAt           time.Time             `json:"updated_at"`
+
+	// Selected fields to marshal. Empty means all fields.
+	fields []string
 }
 
 type vtuberAgency struct {
@@ -68,102 +79,118 @@ type vtuberChannel struct {
 	URL        string             `json:"url" validate:"required,url" mod:"trim"`
 	Image      string             `json:"image"`
 	Subscriber int                `json:"subscriber"`
+	IsLive     bool               `json:"is_live"`
+	IsStale    bool               `json:"is_stale"`
 	Videos     []vtuberVideo      `json:"videos"`
 }
 
 type vtuberVideo struct {
-	ID        string     `json:"id"`
-	Title     string     `json:"title"`
-	URL       string     `json:"url"`
-	Image     string     `json:"image"`
-	StartDate *time.Time `json:"start_date"`
-	EndDate   *time.Time `json:"end_date"`
+	ID               string     `json:"id"`
+	Title            string     `json:"title"`
+	URL              string     `json:"url"`
+	Image            string     `json:"image"`
+	StartDate        *time.Time `json:"start_date"`
+	EndDate          *time.Time `json:"end_date"`
+	ScheduledEndDate *time.Time `json:"scheduled_end_date"`
+	Category         string     `json:"category"`
+	IsRecurring      bool       `json:"is_recurring"`
+}
+
+// GetVtuberByIDRequest is get vtuber by id request model.
+type GetVtuberByIDRequest struct {
+	ID     int64    `validate:"required,gt=0"`
+	AsOf   string   `validate:"omitempty,datetime=2006-01-02" mod:"trim"`
+	Fields []string `mod:"dive,trim,lcase"`
+	Videos string   `validate:"required" mod:"default=all,trim,lcase"`
 }
 
 // GetVtuberByID to get vtuber by id.
-func (s *service) GetVtuberByID(ctx context.Context, id int64) (*vtuber, int, error) {
-	vt, code, err := s.vtuber.GetByID(ctx, id)
+// If as of date is set, the vtuber data will be
+// taken from the latest snapshot at the end of the date.
+func (s *service) GetVtuberByID(ctx context.Context, data GetVtuberByIDRequest) (*vtuber, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	projection, err := s.getVtuberProjection(data.Fields, data.Videos)
 	if err != nil {
-		return nil, code, stack.Wrap(ctx, err)
+		return nil, http.StatusBadRequest, stack.Wrap(ctx, err)
 	}
 
-	agencies := make([]vtuberAgency, len(vt.Agencies))
-	for i, a := range vt.Agencies {
-		agencies[i] = vtuberAgency{
-			ID:    a.ID,
-			Name:  a.Name,
-			Image: a.Image,
+	if data.AsOf != "" {
+		vt, code, err := s.getVtuberSnapshot(ctx, data.ID, s.getAsOfTime(data.AsOf))
+		if err != nil {
+			return nil, code, stack.Wrap(ctx, err)
 		}
+
+		res := s.vtuberFromEntity(s.applyVtuberProjection(*vt, projection))
+		res.fields = data.Fields
+		return &res, http.StatusOK, nil
 	}
 
-	languages := make([]vtuberLanguage, len(vt.Languages))
-	for i, l := range vt.Languages {
-		languages[i] = vtuberLanguage{
-			ID:   l.ID,
-			Name: l.Name,
-		}
+	var vt *entity.Vtuber
+	var code int
+	if s.isFullProjection(projection) {
+		vt, code, err = s.vtuber.GetByID(ctx, data.ID)
+	} else {
+		vt, code, err = s.vtuber.GetProjectionByID(ctx, data.ID, projection)
+	}
+	if err != nil {
+		return nil, code, stack.Wrap(ctx, err)
 	}
 
-	channels := make([]vtuberChannel, len(vt.Channels))
-	for i, c := range vt.Channels {
-		videos := make([]vtuberVideo, len(c.Videos))
-		for j, v := range c.Videos {
-			videos[j] = vtuberVideo{
-				ID:        v.ID,
-				Title:     v.Title,
-				URL:       v.URL,
-				Image:     v.Image,
-				StartDate: v.StartDate,
-				EndDate:   v.EndDate,
-			}
-		}
+	res := s.vtuberFromEntity(*vt)
+	res.fields = data.Fields
+	return &res, http.StatusOK, nil
+}
 
-		channels[i] = vtuberChannel{
-			ID:         c.ID,
-			Name:       c.Name,
-			Type:       c.Type,
-			URL:        c.URL,
-			Image:      c.Image,
-			Subscriber: c.Subscriber,
-			Videos:     videos,
+// GetVtubersByIDsRequest is get vtubers by ids request model.
+type GetVtubersByIDsRequest struct {
+	IDs    []int64  `validate:"required,lte=100,dive,gt=0"`
+	Fields []string `mod:"dive,trim,lcase"`
+	Videos string   `validate:"required" mod:"default=all,trim,lcase"`
+}
+
+// GetVtubersByIDs to get vtubers by ids.
+// The vtubers are in the same order as the ids,
+// and ids not found are returned separately.
+func (s *service) GetVtubersByIDs(ctx context.Context, data GetVtubersByIDsRequest) ([]vtuber, *batch, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return nil, nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	projection, err := s.getVtuberProjection(data.Fields, data.Videos)
+	if err != nil {
+		return nil, nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	ids := s.getUniqueIDs(data.IDs)
+
+	vtubers, code, err := s.vtuber.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, code, stack.Wrap(ctx, err)
+	}
+
+	vtuberMap := make(map[int64]entity.Vtuber)
+	for _, vt := range vtubers {
+		vtuberMap[vt.ID] = vt
+	}
+
+	res := []vtuber{}
+	missing := batch{MissingIDs: []int64{}}
+	for _, id := range ids {
+		vt, ok := vtuberMap[id]
+		if !ok {
+			missing.MissingIDs = append(missing.MissingIDs, id)
+			continue
 		}
+
+		v := s.vtuberFromEntity(s.applyVtuberProjection(vt, projection))
+		v.fields = data.Fields
+		res = append(res, v)
 	}
 
-	return &vtuber{
-		ID:                  vt.ID,
-		Name:                vt.Name,
-		Image:               vt.Image,
-		OriginalNames:       vt.OriginalNames,
-		Nicknames:           vt.Nicknames,
-		Caption:             vt.Caption,
-		DebutDate:           vt.DebutDate,
-		RetirementDate:      vt.RetirementDate,
-		Has2D:               vt.Has2D,
-		Has3D:               vt.Has3D,
-		CharacterDesigners:  vt.CharacterDesigners,
-		Character2DModelers: vt.Character2DModelers,
-		Character3DModelers: vt.Character3DModelers,
-		Agencies:            agencies,
-		Affiliations:        vt.Affiliations,
-		Languages:           languages,
-		Channels:            channels,
-		Subscriber:          vt.Subscriber,
-		MonthlySubscriber:   vt.MonthlySubscriber,
-		VideoCount:          vt.VideoCount,
-		AverageVideoLength:  vt.AverageVideoLength,
-		TotalVideoLength:    vt.TotalVideoLength,
-		SocialMedias:        vt.SocialMedias,
-		OfficialWebsites:    vt.OfficialWebsites,
-		Gender:              vt.Gender,
-		Age:                 vt.Age,
-		Birthday:            vt.Birthday,
-		Height:              vt.Height,
-		Weight:              vt.Weight,
-		BloodType:           vt.BloodType,
-		ZodiacSign:          vt.ZodiacSign,
-		Emoji:               vt.Emoji,
-		UpdatedAt:           vt.UpdatedAt,
-	}, http.StatusOK, nil
+	return res, &missing, http.StatusOK, nil
 }
 
 type vtuberImage struct {
@@ -355,45 +382,69 @@ func (s *service) GetVtuberAgencyTrees(ctx context.Context) (*vtuberAgencyTree,
 
 // GetVtubersRequest is get vtubers request model.
 type GetVtubersRequest struct {
-	Mode               entity.SearchMode    `validate:"oneof=all simple" mod:"default=all,trim,lcase"`
-	Names              string               `validate:"omitempty,gte=3" mod:"trim,lcase"`
-	Name               string               `validate:"omitempty,gte=3" mod:"trim,lcase"`
-	OriginalName       string               `validate:"omitempty,gte=3" mod:"trim,lcase"`
-	Nickname           string               `validate:"omitempty,gte=3" mod:"trim,lcase"`
-	ExcludeActive      bool                 ``
-	ExcludeRetired     bool                 ``
-	DebutDay           int                  `validate:"omitempty,gte=1"`
-	StartDebutMonth    int                  `validate:"omitempty,gte=1"`
-	EndDebutMonth      int                  `validate:"omitempty,gte=1"`
-	StartDebutYear     int                  `validate:"omitempty,gte=1"`
-	EndDebutYear       int                  `validate:"omitempty,gte=1"`
-	StartRetiredMonth  int                  `validate:"omitempty,gte=1"`
-	EndRetiredMonth    int                  `validate:"omitempty,gte=1"`
-	StartRetiredYear   int                  `validate:"omitempty,gte=1"`
-	EndRetiredYear     int                  `validate:"omitempty,gte=1"`
-	Has2D              *bool                ``
-	Has3D              *bool                ``
-	CharacterDesigner  string               `mod:"trim"`
-	Character2DModeler string               `mod:"trim"`
-	Character3DModeler string               `mod:"trim"`
-	InAgency           *bool                ``
-	Agency             string               `mod:"trim"`
-	AgencyID           int64                `validate:"omitempty,gte=1"`
-	LanguageID         int64                `validate:"omitempty,gte=1"`
-	ChannelTypes       []entity.ChannelType `validate:"dive,gte=1" mod:"dive,trim"`
-	BirthdayDay        int                  `validate:"omitempty,gte=1"`
-	StartBirthdayMonth int                  `validate:"omitempty,gte=1"`
-	EndBirthdayMonth   int                  `validate:"omitempty,gte=1"`
-	BloodTypes         []string             `validate:"dive,gte=1" mod:"dive,trim"`
-	Genders            []string             `validate:"dive,gte=1" mod:"dive,trim"`
-	Zodiacs            []string             `validate:"dive,gte=1" mod:"dive,trim"`
-	StartSubscriber    int                  `validate:"omitempty,gte=1"`
-	EndSubscriber      int                  `validate:"omitempty,gte=1"`
-	StartVideoCount    int                  `validate:"omitempty,gte=1"`
-	EndVideoCount      int                  `validate:"omitempty,gte=1"`
-	Sort               string               `validate:"oneof=name -name debut_date -debut_date retirement_date -retirement_date subscriber -subscriber monthly_subscriber -monthly_subscriber video_count -video_count average_video_length -average_video_length total_video_length -total_video_length" mod:"default=name,trim,lcase"`
-	Page               int                  `validate:"required,gte=1" mod:"default=1"`
-	Limit              int                  `validate:"required,gte=-1" mod:"default=20"`
+	Mode                       entity.SearchMode         `validate:"oneof=all simple" mod:"default=all,trim,lcase"`
+	Query                      string                    `validate:"omitempty,gte=2" mod:"trim"`
+	Names                      string                    `validate:"omitempty,gte=3" mod:"trim,lcase"`
+	Name                       string                    `validate:"omitempty,gte=3" mod:"trim,lcase"`
+	OriginalName               string                    `validate:"omitempty,gte=3" mod:"trim,lcase"`
+	Nickname                   string                    `validate:"omitempty,gte=3" mod:"trim,lcase"`
+	ExcludeActive              bool                      ``
+	ExcludeRetired             bool                      ``
+	DebutDay                   int                       `validate:"omitempty,gte=1"`
+	StartDebutMonth            int                       `validate:"omitempty,gte=1"`
+	EndDebutMonth              int                       `validate:"omitempty,gte=1"`
+	StartDebutYear             int                       `validate:"omitempty,gte=1"`
+	EndDebutYear               int                       `validate:"omitempty,gte=1"`
+	StartRetiredMonth          int                       `validate:"omitempty,gte=1"`
+	EndRetiredMonth            int                       `validate:"omitempty,gte=1"`
+	StartRetiredYear           int                       `validate:"omitempty,gte=1"`
+	EndRetiredYear             int                       `validate:"omitempty,gte=1"`
+	Has2D                      *bool                     ``
+	Has3D                      *bool                     ``
+	CharacterDesigner          string                    `mod:"trim"`
+	CharacterDesigners         []string                  `validate:"dive,gte=1" mod:"dive,trim"`
+	ExcludeCharacterDesigners  []string                  `validate:"dive,gte=1" mod:"dive,trim"`
+	CharacterDesignerMatch     entity.MatchMode          `validate:"oneof=any all" mod:"default=any,trim,lcase"`
+	Character2DModeler         string                    `mod:"trim"`
+	Character2DModelers        []string                  `validate:"dive,gte=1" mod:"dive,trim"`
+	ExcludeCharacter2DModelers []string                  `validate:"dive,gte=1" mod:"dive,trim"`
+	Character2DModelerMatch    entity.MatchMode          `validate:"oneof=any all" mod:"default=any,trim,lcase"`
+	Character3DModeler         string                    `mod:"trim"`
+	Character3DModelers        []string                  `validate:"dive,gte=1" mod:"dive,trim"`
+	ExcludeCharacter3DModelers []string                  `validate:"dive,gte=1" mod:"dive,trim"`
+	Character3DModelerMatch    entity.MatchMode          `validate:"oneof=any all" mod:"default=any,trim,lcase"`
+	InAgency                   *bool                     ``
+	Agency                     string                    `mod:"trim"`
+	AgencyID                   int64                     `validate:"omitempty,gte=1"`
+	AgencyIDs                  []int64                   `validate:"dive,gte=1"`
+	ExcludeAgencyIDs           []int64                   `validate:"dive,gte=1"`
+	AgencyMatch                entity.MatchMode          `validate:"oneof=any all" mod:"default=any,trim,lcase"`
+	LanguageID                 int64                     `validate:"omitempty,gte=1"`
+	LanguageIDs                []int64                   `validate:"dive,gte=1"`
+	ExcludeLanguageIDs         []int64                   `validate:"dive,gte=1"`
+	LanguageMatch              entity.MatchMode          `validate:"oneof=any all" mod:"default=any,trim,lcase"`
+	ChannelTypes               []entity.ChannelType      `validate:"dive,gte=1" mod:"dive,trim"`
+	ExcludeChannelTypes        []entity.ChannelType      `validate:"dive,gte=1" mod:"dive,trim"`
+	ChannelTypeMatch           entity.MatchMode          `validate:"oneof=any all" mod:"default=all,trim,lcase"`
+	ActivityStatuses           []entity.ActivityStatus   `validate:"dive,oneof=ACTIVE HIATUS DORMANT RETIRED -ACTIVE -HIATUS -DORMANT -RETIRED" mod:"dive,trim,ucase"`
+	BirthdayDay                int                       `validate:"omitempty,gte=1"`
+	StartBirthdayMonth         int                       `validate:"omitempty,gte=1"`
+	EndBirthdayMonth           int                       `validate:"omitempty,gte=1"`
+	BloodTypes                 []string                  `validate:"dive,gte=1" mod:"dive,trim"`
+	Genders                    []string                  `validate:"dive,gte=1" mod:"dive,trim"`
+	Zodiacs                    []string                  `validate:"dive,gte=1" mod:"dive,trim"`
+	StartSubscriber            int                       `validate:"omitempty,gte=1"`
+	EndSubscriber              int                       `validate:"omitempty,gte=1"`
+	SubscriberPlatform         entity.SubscriberPlatform `validate:"omitempty,oneof=YOUTUBE TWITCH BILIBILI NICONICO TOTAL" mod:"trim,ucase"`
+	StartVideoCount            int                       `validate:"omitempty,gte=1"`
+	EndVideoCount              int                       `validate:"omitempty,gte=1"`
+	Filter                     string                    `validate:"omitempty,lte=1000" mod:"trim"`
+	Sort                       string                    `validate:"oneof=relevance name -name debut_date -debut_date retirement_date -retirement_date subscriber -subscriber youtube_subscriber -youtube_subscriber twitch_subscriber -twitch_subscriber bilibili_subscriber -bilibili_subscriber niconico_subscriber -niconico_subscriber total_subscriber -total_subscriber monthly_subscriber -monthly_subscriber video_count -video_count average_video_length -average_video_length total_video_length -total_video_length activity_status -activity_status last_activity_date -last_activity_date" mod:"default=name,trim,lcase"`
+	Page                       int                       `validate:"required,gte=1" mod:"default=1"`
+	Limit                      int                       `validate:"required,gte=-1" mod:"default=20"`
+	Cursor                     string                    `mod:"trim"`
+	Fields                     []string                  `mod:"dive,trim,lcase"`
+	Videos                     string                    `validate:"required" mod:"default=all,trim,lcase"`
 }
 
 // GetVtubers to get vtuber list.
@@ -402,46 +453,104 @@ func (s *service) GetVtubers(ctx context.Context, data GetVtubersRequest) ([]vtu
 		return nil, nil, http.StatusBadRequest, stack.Wrap(ctx, err)
 	}
 
-	vtubers, total, code, err := s.vtuber.GetAll(ctx, entity.GetAllRequest{
-		Mode:               data.Mode,
-		Names:              data.Names,
-		Name:               data.Name,
-		OriginalName:       data.OriginalName,
-		Nickname:           data.Nickname,
-		ExcludeActive:      data.ExcludeActive,
-		ExcludeRetired:     data.ExcludeRetired,
-		DebutDay:           data.DebutDay,
-		StartDebutMonth:    data.StartDebutMonth,
-		EndDebutMonth:      data.EndDebutMonth,
-		StartDebutYear:     data.StartDebutYear,
-		EndDebutYear:       data.EndDebutYear,
-		StartRetiredMonth:  data.StartRetiredMonth,
-		EndRetiredMonth:    data.EndRetiredMonth,
-		StartRetiredYear:   data.StartRetiredYear,
-		EndRetiredYear:     data.EndRetiredYear,
-		Has2D:              data.Has2D,
-		Has3D:              data.Has3D,
-		CharacterDesigner:  data.CharacterDesigner,
-		Character2DModeler: data.Character2DModeler,
-		Character3DModeler: data.Character3DModeler,
-		InAgency:           data.InAgency,
-		Agency:             data.Agency,
-		AgencyID:           data.AgencyID,
-		LanguageID:         data.LanguageID,
-		ChannelTypes:       data.ChannelTypes,
-		BirthdayDay:        data.BirthdayDay,
-		StartBirthdayMonth: data.StartBirthdayMonth,
-		EndBirthdayMonth:   data.EndBirthdayMonth,
-		BloodTypes:         data.BloodTypes,
-		Genders:            data.Genders,
-		Zodiacs:            data.Zodiacs,
-		StartSubscriber:    data.StartSubscriber,
-		EndSubscriber:      data.EndSubscriber,
-		StartVideoCount:    data.StartVideoCount,
-		EndVideoCount:      data.EndVideoCount,
-		Sort:               data.Sort,
-		Page:               data.Page,
-		Limit:              data.Limit,
+	if data.Sort == "relevance" && data.Query == "" {
+		return nil, nil, http.StatusBadRequest, stack.Wrap(ctx, errors.ErrRequiredField("query"))
+	}
+
+	var filter *entity.Filter
+	if data.Filter != "" {
+		f, err := s.parseVtuberFilter(data.Filter)
+		if err != nil {
+			return nil, nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+		}
+		filter = f
+	}
+
+	projection, err := s.getVtuberProjection(data.Fields, data.Videos)
+	if err != nil {
+		return nil, nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	var searchIDs []int64
+	if data.Query != "" {
+		ids, code, err := s.searchVtubers(ctx, data.Query)
+		if err != nil {
+			return nil, nil, code, stack.Wrap(ctx, err)
+		}
+
+		if len(ids) == 0 {
+			return []vtuber{}, &pagination{
+				Page:  data.Page,
+				Limit: data.Limit,
+			}, http.StatusOK, nil
+		}
+
+		searchIDs = ids
+	}
+
+	vtubers, total, nextCursor, code, err := s.vtuber.GetAll(ctx, entity.GetAllRequest{
+		Mode:                       data.Mode,
+		SearchIDs:                  searchIDs,
+		Names:                      data.Names,
+		Name:                       data.Name,
+		OriginalName:               data.OriginalName,
+		Nickname:                   data.Nickname,
+		ExcludeActive:              data.ExcludeActive,
+		ExcludeRetired:             data.ExcludeRetired,
+		DebutDay:                   data.DebutDay,
+		StartDebutMonth:            data.StartDebutMonth,
+		EndDebutMonth:              data.EndDebutMonth,
+		StartDebutYear:             data.StartDebutYear,
+		EndDebutYear:               data.EndDebutYear,
+		StartRetiredMonth:          data.StartRetiredMonth,
+		EndRetiredMonth:            data.EndRetiredMonth,
+		StartRetiredYear:           data.StartRetiredYear,
+		EndRetiredYear:             data.EndRetiredYear,
+		Has2D:                      data.Has2D,
+		Has3D:                      data.Has3D,
+		CharacterDesigner:          data.CharacterDesigner,
+		CharacterDesigners:         data.CharacterDesigners,
+		ExcludeCharacterDesigners:  data.ExcludeCharacterDesigners,
+		CharacterDesignerMatch:     data.CharacterDesignerMatch,
+		Character2DModeler:         data.Character2DModeler,
+		Character2DModelers:        data.Character2DModelers,
+		ExcludeCharacter2DModelers: data.ExcludeCharacter2DModelers,
+		Character2DModelerMatch:    data.Character2DModelerMatch,
+		Character3DModeler:         data.Character3DModeler,
+		Character3DModelers:        data.Character3DModelers,
+		ExcludeCharacter3DModelers: data.ExcludeCharacter3DModelers,
+		Character3DModelerMatch:    data.Character3DModelerMatch,
+		InAgency:                   data.InAgency,
+		Agency:                     data.Agency,
+		AgencyID:                   data.AgencyID,
+		AgencyIDs:                  data.AgencyIDs,
+		ExcludeAgencyIDs:           data.ExcludeAgencyIDs,
+		AgencyMatch:                data.AgencyMatch,
+		LanguageID:                 data.LanguageID,
+		LanguageIDs:                data.LanguageIDs,
+		ExcludeLanguageIDs:         data.ExcludeLanguageIDs,
+		LanguageMatch:              data.LanguageMatch,
+		ChannelTypes:               data.ChannelTypes,
+		ExcludeChannelTypes:        data.ExcludeChannelTypes,
+		ChannelTypeMatch:           data.ChannelTypeMatch,
+		ActivityStatuses:           data.ActivityStatuses,
+		BirthdayDay:                data.BirthdayDay,
+		StartBirthdayMonth:         data.StartBirthdayMonth,
+		EndBirthdayMonth:           data.EndBirthdayMonth,
+		BloodTypes:                 data.BloodTypes,
+		Genders:                    data.Genders,
+		Zodiacs:                    data.Zodiacs,
+		StartSubscriber:            data.StartSubscriber,
+		EndSubscriber:              data.EndSubscriber,
+		SubscriberPlatform:         data.SubscriberPlatform,
+		StartVideoCount:            data.StartVideoCount,
+		EndVideoCount:              data.EndVideoCount,
+		Filter:                     filter,
+		Projection:                 projection,
+		Sort:                       data.Sort,
+		Page:                       data.Page,
+		Limit:                      data.Limit,
+		Cursor:                     data.Cursor,
 	})
 	if err != nil {
 		return nil, nil, code, stack.Wrap(ctx, err)
@@ -449,90 +558,108 @@ func (s *service) GetVtubers(ctx context.Context, data GetVtubersRequest) ([]vtu
 
 	res := make([]vtuber, len(vtubers))
 	for i, vt := range vtubers {
-		agencies := make([]vtuberAgency, len(vt.Agencies))
-		for i, a := range vt.Agencies {
-			agencies[i] = vtuberAgency{
-				ID:    a.ID,
-				Name:  a.Name,
-				Image: a.Image,
-			}
-		}
+		res[i] = s.vtuberFromEntity(vt)
+		res[i].fields = data.Fields
+	}
 
-		languages := make([]vtuberLanguage, len(vt.Languages))
-		for i, l := range vt.Languages {
-			languages[i] = vtuberLanguage{
-				ID:   l.ID,
-				Name: l.Name,
-			}
+	return res, &pagination{
+		Page:       data.Page,
+		Limit:      data.Limit,
+		Total:      total,
+		NextCursor: nextCursor,
+	}, http.StatusOK, nil
+}
+
+func (s *service) vtuberFromEntity(vt entity.Vtuber) vtuber {
+	agencies := make([]vtuberAgency, len(vt.Agencies))
+	for i, a := range vt.Agencies {
+		agencies[i] = vtuberAgency{
+			ID:    a.ID,
+			Name:  a.Name,
+			Image: a.Image,
 		}
+	}
 
-		channels := make([]vtuberChannel, len(vt.Channels))
-		for i, c := range vt.Channels {
-			videos := make([]vtuberVideo, len(c.Videos))
-			for j, v := range c.Videos {
-				videos[j] = vtuberVideo{
-					ID:        v.ID,
-					Title:     v.Title,
-					URL:       v.URL,
-					Image:     v.Image,
-					StartDate: v.StartDate,
-					EndDate:   v.EndDate,
-				}
-			}
+	languages := make([]vtuberLanguage, len(vt.Languages))
+	for i, l := range vt.Languages {
+		languages[i] = vtuberLanguage{
+			ID:   l.ID,
+			Name: l.Name,
+		}
+	}
 
-			channels[i] = vtuberChannel{
-				ID:         c.ID,
-				Name:       c.Name,
-				Type:       c.Type,
-				URL:        c.URL,
-				Image:      c.Image,
-				Subscriber: c.Subscriber,
-				Videos:     videos,
+	channels := make([]vtuberChannel, len(vt.Channels))
+	for i, c := range vt.Channels {
+		videos := make([]vtuberVideo, len(c.Videos))
+		for j, v := range c.Videos {
+			videos[j] = vtuberVideo{
+				ID:               v.ID,
+				Title:            v.Title,
+				URL:              v.URL,
+				Image:            v.Image,
+				StartDate:        v.StartDate,
+				EndDate:          v.EndDate,
+				ScheduledEndDate: v.ScheduledEndDate,
+				Category:         v.Category,
+				IsRecurring:      v.IsRecurring,
 			}
 		}
 
-		res[i] = vtuber{
-			ID:                  vt.ID,
-			Name:                vt.Name,
-			Image:               vt.Image,
-			OriginalNames:       vt.OriginalNames,
-			Nicknames:           vt.Nicknames,
-			Caption:             vt.Caption,
-			DebutDate:           vt.DebutDate,
-			RetirementDate:      vt.RetirementDate,
-			Has2D:               vt.Has2D,
-			Has3D:               vt.Has3D,
-			CharacterDesigners:  vt.CharacterDesigners,
-			Character2DModelers: vt.Character2DModelers,
-			Character3DModelers: vt.Character3DModelers,
-			Agencies:            agencies,
-			Affiliations:        vt.Affiliations,
-			Languages:           languages,
-			Channels:            channels,
-			Subscriber:          vt.Subscriber,
-			MonthlySubscriber:   vt.MonthlySubscriber,
-			VideoCount:          vt.VideoCount,
-			AverageVideoLength:  vt.AverageVideoLength,
-			TotalVideoLength:    vt.TotalVideoLength,
-			SocialMedias:        vt.SocialMedias,
-			OfficialWebsites:    vt.OfficialWebsites,
-			Gender:              vt.Gender,
-			Age:                 vt.Age,
-			Birthday:            vt.Birthday,
-			Height:              vt.Height,
-			Weight:              vt.Weight,
-			BloodType:           vt.BloodType,
-			ZodiacSign:          vt.ZodiacSign,
-			Emoji:               vt.Emoji,
-			UpdatedAt:           vt.UpdatedAt,
+		channels[i] = vtuberChannel{
+			ID:         c.ID,
+			Name:       c.Name,
+			Type:       c.Type,
+			URL:        c.URL,
+			Image:      c.Image,
+			Subscriber: c.Subscriber,
+			IsLive:     c.IsLive,
+			IsStale:    c.IsStale,
+			Videos:     videos,
 		}
 	}
 
-	return res, &pagination{
-		Page:  data.Page,
-		Limit: data.Limit,
-		Total: total,
-	}, http.StatusOK, nil
+	return vtuber{
+		ID:                  vt.ID,
+		Name:                vt.Name,
+		Image:               vt.Image,
+		OriginalNames:       vt.OriginalNames,
+		Nicknames:           vt.Nicknames,
+		Caption:             vt.Caption,
+		DebutDate:           vt.DebutDate,
+		RetirementDate:      vt.RetirementDate,
+		Has2D:               vt.Has2D,
+		Has3D:               vt.Has3D,
+		CharacterDesigners:  vt.CharacterDesigners,
+		Character2DModelers: vt.Character2DModelers,
+		Character3DModelers: vt.Character3DModelers,
+		Agencies:            agencies,
+		Affiliations:        vt.Affiliations,
+		Languages:           languages,
+		Channels:            channels,
+		Subscriber:          vt.Subscriber,
+		YoutubeSubscriber:   vt.YoutubeSubscriber,
+		TwitchSubscriber:    vt.TwitchSubscriber,
+		BilibiliSubscriber:  vt.BilibiliSubscriber,
+		NiconicoSubscriber:  vt.NiconicoSubscriber,
+		TotalSubscriber:     vt.TotalSubscriber,
+		MonthlySubscriber:   vt.MonthlySubscriber,
+		VideoCount:          vt.VideoCount,
+		AverageVideoLength:  vt.AverageVideoLength,
+		TotalVideoLength:    vt.TotalVideoLength,
+		LastActivityDate:    vt.LastActivityDate,
+		ActivityStatus:      vt.ActivityStatus,
+		SocialMedias:        vt.SocialMedias,
+		OfficialWebsites:    vt.OfficialWebsites,
+		Gender:              vt.Gender,
+		Age:                 vt.Age,
+		Birthday:            vt.Birthday,
+		Height:              vt.Height,
+		Weight:              vt.Weight,
+		BloodType:           vt.BloodType,
+		ZodiacSign:          vt.ZodiacSign,
+		Emoji:               vt.Emoji,
+		UpdatedAt:           vt.UpdatedAt,
+	}
 }
 
 // GetVtuberCharacterDesigners to get vtuber character designer list.
@@ -569,14 +696,18 @@ type vtuberChannelHistory struct {
 	ChannelID   string             `json:"channel_id"`
 	ChannelType entity.ChannelType `json:"channel_type"`
 	Subscriber  int                `json:"subscriber"`
+	ViewCount   int                `json:"view_count"`
+	VideoCount  int                `json:"video_count"`
+	StreamHours float64            `json:"stream_hours"`
 }
 
 // GetVtuberChannelHistoriesRequest is get vtuber channel histories request model.
 type GetVtuberChannelHistoriesRequest struct {
-	ID        int64               `validate:"required,gt=0"`
-	StartDate string              `validate:"omitempty,datetime=2006-01-02" mod:"trim"`
-	EndDate   string              `validate:"omitempty,datetime=2006-01-02" mod:"trim"`
-	Group     historyEntity.Group `validate:"oneof=DAILY MONTHLY YEARLY" mod:"trim,ucase,default=MONTHLY"`
+	ID          int64                     `validate:"required,gt=0"`
+	StartDate   string                    `validate:"omitempty,datetime=2006-01-02" mod:"trim"`
+	EndDate     string                    `validate:"omitempty,datetime=2006-01-02" mod:"trim"`
+	Group       historyEntity.Group       `validate:"oneof=DAILY MONTHLY YEARLY" mod:"trim,ucase,default=MONTHLY"`
+	Aggregation historyEntity.Aggregation `validate:"oneof=MAX LAST AVG SUM" mod:"trim,ucase,default=MAX"`
 }
 
 // GetVtuberChannelHistoriesByID to get vtuber channel histories by id.
@@ -601,44 +732,38 @@ func (s *service) GetVtuberChannelHistoriesByID(ctx context.Context, data GetVtu
 	}
 
 	histories, code, err := s.channelStatsHistory.Get(ctx, historyEntity.GetRequest{
-		VtuberID:  data.ID,
-		StartDate: utils.StrToTime("2006-01-02", data.StartDate),
-		EndDate:   utils.StrToTime("2006-01-02", data.EndDate),
+		VtuberID:    data.ID,
+		StartDate:   utils.StrToTime("2006-01-02", data.StartDate),
+		EndDate:     utils.StrToTime("2006-01-02", data.EndDate),
+		Group:       data.Group,
+		Aggregation: data.Aggregation,
 	})
 	if err != nil {
 		return nil, code, stack.Wrap(ctx, err)
 	}
 
-	m := make(map[vtuberChannelHistory]int)
-	for _, history := range histories {
-		year := history.CreatedAt.Year()
-		month := int(history.CreatedAt.Month())
-		day := history.CreatedAt.Day()
-
-		key := vtuberChannelHistory{
+	res := make([]vtuberChannelHistory, len(histories))
+	for i, history := range histories {
+		res[i] = vtuberChannelHistory{
 			ChannelID:   history.ChannelID,
 			ChannelType: history.ChannelType,
+			Subscriber:  history.Subscriber,
+			ViewCount:   history.ViewCount,
+			VideoCount:  history.VideoCount,
+			StreamHours: float64(history.StreamDuration) / 3600,
 		}
 
 		switch data.Group {
 		case historyEntity.Yearly:
-			key.Year = year
+			res[i].Year = history.CreatedAt.Year()
 		case historyEntity.Monthly:
-			key.Year = year
-			key.Month = month
+			res[i].Year = history.CreatedAt.Year()
+			res[i].Month = int(history.CreatedAt.Month())
 		case historyEntity.Daily:
-			key.Year = year
-			key.Month = month
-			key.Day = day
+			res[i].Year = history.CreatedAt.Year()
+			res[i].Month = int(history.CreatedAt.Month())
+			res[i].Day = history.CreatedAt.Day()
 		}
-
-		m[key] = max(m[key], history.Subscriber)
-	}
-
-	res := make([]vtuberChannelHistory, 0)
-	for k, v := range m {
-		k.Subscriber = v
-		res = append(res, k)
 	}
 
 	sort.Slice(res, func(i, j int) bool {
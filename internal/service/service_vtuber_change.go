@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rl404/fairy/errors/stack"
+	vtuberEntity "github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/domain/vtuber_change/entity"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+type vtuberChange struct {
+	VtuberID   int64         `json:"vtuber_id"`
+	VtuberName string        `json:"vtuber_name"`
+	Field      string        `json:"field"`
+	Source     entity.Source `json:"source"`
+	OldValue   string        `json:"old_value"`
+	NewValue   string        `json:"new_value"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+// GetVtuberChangesRequest is get vtuber change list request model.
+type GetVtuberChangesRequest struct {
+	ID     int64         `validate:"gte=0"`
+	Field  string        `validate:"omitempty,oneof=name image original_names nicknames caption debut_date retirement_date has_2d has_3d character_designers character_2d_modelers character_3d_modelers agencies affiliations languages channels social_medias official_websites gender age birthday height weight blood_type zodiac_sign emoji" mod:"trim,lcase"`
+	Source entity.Source `validate:"omitempty,oneof=WIKI OVERRIDE ADMIN" mod:"trim,ucase"`
+	Page   int           `validate:"required,gte=1" mod:"default=1"`
+	Limit  int           `validate:"required,gte=-1" mod:"default=20"`
+}
+
+// GetVtuberChanges to get vtuber change list.
+func (s *service) GetVtuberChanges(ctx context.Context, data GetVtuberChangesRequest) ([]vtuberChange, *pagination, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return nil, nil, http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	changes, total, code, err := s.vtuberChange.GetAll(ctx, entity.GetAllRequest{
+		VtuberID: data.ID,
+		Field:    data.Field,
+		Source:   data.Source,
+		Page:     data.Page,
+		Limit:    data.Limit,
+	})
+	if err != nil {
+		return nil, nil, code, stack.Wrap(ctx, err)
+	}
+
+	res := make([]vtuberChange, len(changes))
+	for i, c := range changes {
+		res[i] = vtuberChange{
+			VtuberID:   c.VtuberID,
+			VtuberName: c.VtuberName,
+			Field:      c.Field,
+			Source:     c.Source,
+			OldValue:   c.OldValue,
+			NewValue:   c.NewValue,
+			CreatedAt:  c.CreatedAt,
+		}
+	}
+
+	return res, &pagination{
+		Page:  data.Page,
+		Limit: data.Limit,
+		Total: total,
+	}, http.StatusOK, nil
+}
+
+type vtuberField struct {
+	name       string
+	value      func(v vtuberEntity.Vtuber) string
+	key        func(v vtuberEntity.Vtuber) string // to compare, value is used if nil.
+	overridden func(o vtuberEntity.OverriddenField) bool
+}
+
+func (s *service) getVtuberFields() []vtuberField {
+	return []vtuberField{
+		{name: "name", value: func(v vtuberEntity.Vtuber) string { return v.Name }},
+		{name: "image", value: func(v vtuberEntity.Vtuber) string { return v.Image }},
+		{name: "original_names", value: func(v vtuberEntity.Vtuber) string { return s.changeStrings(v.OriginalNames) }},
+		{name: "nicknames", value: func(v vtuberEntity.Vtuber) string { return s.changeStrings(v.Nicknames) }},
+		{name: "caption", value: func(v vtuberEntity.Vtuber) string { return v.Caption }},
+		{
+			name:       "debut_date",
+			value:      func(v vtuberEntity.Vtuber) string { return s.changeDate(v.DebutDate) },
+			overridden: func(o vtuberEntity.OverriddenField) bool { return o.DebutDate.Flag },
+		},
+		{
+			name:       "retirement_date",
+			value:      func(v vtuberEntity.Vtuber) string { return s.changeDate(v.RetirementDate) },
+			overridden: func(o vtuberEntity.OverriddenField) bool { return o.RetirementDate.Flag },
+		},
+		{name: "has_2d", value: func(v vtuberEntity.Vtuber) string { return strconv.FormatBool(v.Has2D) }},
+		{name: "has_3d", value: func(v vtuberEntity.Vtuber) string { return strconv.FormatBool(v.Has3D) }},
+		{name: "character_designers", value: func(v vtuberEntity.Vtuber) string { return s.changeStrings(v.CharacterDesigners) }},
+		{name: "character_2d_modelers", value: func(v vtuberEntity.Vtuber) string { return s.changeStrings(v.Character2DModelers) }},
+		{name: "character_3d_modelers", value: func(v vtuberEntity.Vtuber) string { return s.changeStrings(v.Character3DModelers) }},
+		{
+			name:       "agencies",
+			value:      func(v vtuberEntity.Vtuber) string { return s.changeAgencies(v.Agencies) },
+			key:        func(v vtuberEntity.Vtuber) string { return s.changeAgencyIDs(v.Agencies) },
+			overridden: func(o vtuberEntity.OverriddenField) bool { return o.Agencies.Flag },
+		},
+		{
+			name:       "affiliations",
+			value:      func(v vtuberEntity.Vtuber) string { return s.changeStrings(v.Affiliations) },
+			overridden: func(o vtuberEntity.OverriddenField) bool { return o.Affiliations.Flag },
+		},
+		{name: "languages", value: func(v vtuberEntity.Vtuber) string { return s.changeLanguages(v.Languages) }},
+		{
+			name:       "channels",
+			value:      func(v vtuberEntity.Vtuber) string { return s.changeChannels(v.Channels) },
+			overridden: func(o vtuberEntity.OverriddenField) bool { return o.Channels.Flag },
+		},
+		{name: "social_medias", value: func(v vtuberEntity.Vtuber) string { return s.changeStrings(v.SocialMedias) }},
+		{name: "official_websites", value: func(v vtuberEntity.Vtuber) string { return s.changeStrings(v.OfficialWebsites) }},
+		{name: "gender", value: func(v vtuberEntity.Vtuber) string { return v.Gender }},
+		{name: "age", value: func(v vtuberEntity.Vtuber) string { return s.changeFloat(v.Age) }},
+		{name: "birthday", value: func(v vtuberEntity.Vtuber) string { return s.changeDate(v.Birthday) }},
+		{name: "height", value: func(v vtuberEntity.Vtuber) string { return s.changeFloat(v.Height) }},
+		{name: "weight", value: func(v vtuberEntity.Vtuber) string { return s.changeFloat(v.Weight) }},
+		{name: "blood_type", value: func(v vtuberEntity.Vtuber) string { return v.BloodType }},
+		{name: "zodiac_sign", value: func(v vtuberEntity.Vtuber) string { return v.ZodiacSign }},
+		{name: "emoji", value: func(v vtuberEntity.Vtuber) string { return v.Emoji }},
+	}
+}
+
+func (s *service) getVtuberChanges(oldVtuber *vtuberEntity.Vtuber, newVtuber vtuberEntity.Vtuber) []entity.Change {
+	if oldVtuber == nil {
+		return nil
+	}
+
+	var changes []entity.Change
+	for _, field := range s.getVtuberFields() {
+		oldValue, newValue := field.value(*oldVtuber), field.value(newVtuber)
+
+		key := field.key
+		if key == nil {
+			key = field.value
+		}
+
+		if key(*oldVtuber) == key(newVtuber) {
+			continue
+		}
+
+		source := entity.SourceWiki
+		if field.overridden != nil && field.overridden(newVtuber.OverriddenField) {
+			source = entity.SourceOverride
+		}
+
+		changes = append(changes, entity.Change{
+			VtuberID:   newVtuber.ID,
+			VtuberName: newVtuber.Name,
+			Field:      field.name,
+			Source:     source,
+			OldValue:   oldValue,
+			NewValue:   newValue,
+		})
+	}
+
+	return changes
+}
+
+func (s *service) getOverriddenFieldChanges(vtuber vtuberEntity.Vtuber, newField vtuberEntity.OverriddenField) []entity.Change {
+	oldField := vtuber.OverriddenField
+
+	fields := []struct {
+		name     string
+		oldValue string
+		newValue string
+		isSame   bool
+	}{
+		{
+			name:     "debut_date",
+			oldValue: s.changeOverridden(oldField.DebutDate.Flag, s.changeDate(oldField.DebutDate.Value)),
+			newValue: s.changeOverridden(newField.DebutDate.Flag, s.changeDate(newField.DebutDate.Value)),
+		},
+		{
+			name:     "retirement_date",
+			oldValue: s.changeOverridden(oldField.RetirementDate.Flag, s.changeDate(oldField.RetirementDate.Value)),
+			newValue: s.changeOverridden(newField.RetirementDate.Flag, s.changeDate(newField.RetirementDate.Value)),
+		},
+		{
+			name:     "agencies",
+			oldValue: s.changeOverridden(oldField.Agencies.Flag, s.changeAgencies(oldField.Agencies.Value)),
+			newValue: s.changeOverridden(newField.Agencies.Flag, s.changeAgencies(newField.Agencies.Value)),
+			isSame: oldField.Agencies.Flag == newField.Agencies.Flag &&
+				s.changeAgencyIDs(oldField.Agencies.Value) == s.changeAgencyIDs(newField.Agencies.Value),
+		},
+		{
+			name:     "affiliations",
+			oldValue: s.changeOverridden(oldField.Affiliations.Flag, s.changeStrings(oldField.Affiliations.Value)),
+			newValue: s.changeOverridden(newField.Affiliations.Flag, s.changeStrings(newField.Affiliations.Value)),
+		},
+		{
+			name:     "channels",
+			oldValue: s.changeOverridden(oldField.Channels.Flag, s.changeChannels(oldField.Channels.Value)),
+			newValue: s.changeOverridden(newField.Channels.Flag, s.changeChannels(newField.Channels.Value)),
+		},
+	}
+
+	var changes []entity.Change
+	for _, field := range fields {
+		if field.oldValue == field.newValue || field.isSame {
+			continue
+		}
+
+		changes = append(changes, entity.Change{
+			VtuberID:   vtuber.ID,
+			VtuberName: vtuber.Name,
+			Field:      field.name,
+			Source:     entity.SourceAdmin,
+			OldValue:   field.oldValue,
+			NewValue:   field.newValue,
+		})
+	}
+
+	return changes
+}
+
+func (s *service) changeOverridden(flag bool, value string) string {
+	if !flag {
+		return ""
+	}
+	return value
+}
+
+func (s *service) changeDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+func (s *service) changeFloat(f *float64) string {
+	if f == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*f, 'f', -1, 64)
+}
+
+func (s *service) changeStrings(values []string) string {
+	sorted := make([]string, len(values))
+	copy(sorted, values)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ", ")
+}
+
+func (s *service) changeAgencies(agencies []vtuberEntity.Agency) string {
+	names := make([]string, len(agencies))
+	for i, a := range agencies {
+		names[i] = a.Name
+	}
+	return s.changeStrings(names)
+}
+
+func (s *service) changeAgencyIDs(agencies []vtuberEntity.Agency) string {
+	ids := make([]string, len(agencies))
+	for i, a := range agencies {
+		ids[i] = strconv.FormatInt(a.ID, 10)
+	}
+	return s.changeStrings(ids)
+}
+
+func (s *service) changeLanguages(languages []vtuberEntity.Language) string {
+	names := make([]string, len(languages))
+	for i, l := range languages {
+		names[i] = l.Name
+	}
+	return s.changeStrings(names)
+}
+
+func (s *service) changeChannels(channels []vtuberEntity.Channel) string {
+	urls := make([]string, len(channels))
+	for i, c := range channels {
+		urls[i] = c.URL
+	}
+	return s.changeStrings(urls)
+}
@@ -0,0 +1,412 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/errors"
+)
+
+const (
+	filterMaxDepth     = 5
+	filterMaxCondition = 20
+	filterMaxValue     = 50
+)
+
+type filterFieldType int
+
+const (
+	filterText filterFieldType = iota
+	filterNumber
+	filterBool
+	filterDate
+)
+
+// filterFields is list of vtuber fields allowed in filter expression.
+var filterFields = map[string]filterFieldType{
+	"name":                 filterText,
+	"original_name":        filterText,
+	"nickname":             filterText,
+	"retired":              filterBool,
+	"debut_date":           filterDate,
+	"retirement_date":      filterDate,
+	"has_2d":               filterBool,
+	"has_3d":               filterBool,
+	"character_designer":   filterText,
+	"character_2d_modeler": filterText,
+	"character_3d_modeler": filterText,
+	"in_agency":            filterBool,
+	"agency":               filterText,
+	"agency_id":            filterNumber,
+	"language":             filterText,
+	"language_id":          filterNumber,
+	"channel_type":         filterText,
+	"birthday":             filterDate,
+	"blood_type":           filterText,
+	"gender":               filterText,
+	"zodiac_sign":          filterText,
+	"subscriber":           filterNumber,
+	"youtube_subscriber":   filterNumber,
+	"twitch_subscriber":    filterNumber,
+	"bilibili_subscriber":  filterNumber,
+	"niconico_subscriber":  filterNumber,
+	"total_subscriber":     filterNumber,
+	"monthly_subscriber":   filterNumber,
+	"video_count":          filterNumber,
+	"average_video_length": filterNumber,
+	"total_video_length":   filterNumber,
+	"activity_status":      filterText,
+	"last_activity_date":   filterDate,
+}
+
+// filterOperators is list of comparison operators allowed for each field type.
+var filterOperators = map[filterFieldType][]entity.FilterOperator{
+	filterText:   {entity.FilterEq, entity.FilterNe, entity.FilterIn, entity.FilterContains},
+	filterNumber: {entity.FilterEq, entity.FilterNe, entity.FilterGt, entity.FilterGte, entity.FilterLt, entity.FilterLte, entity.FilterIn},
+	filterBool:   {entity.FilterEq, entity.FilterNe},
+	filterDate:   {entity.FilterGt, entity.FilterGte, entity.FilterLt, entity.FilterLte},
+}
+
+var filterSymbols = map[string]entity.FilterOperator{
+	":":  entity.FilterEq,
+	"=":  entity.FilterEq,
+	"!=": entity.FilterNe,
+	">":  entity.FilterGt,
+	">=": entity.FilterGte,
+	"<":  entity.FilterLt,
+	"<=": entity.FilterLte,
+	"~":  entity.FilterContains,
+}
+
+type filterTokenType int
+
+const (
+	filterTokenEOF filterTokenType = iota
+	filterTokenWord
+	filterTokenString
+	filterTokenSymbol
+)
+
+type filterToken struct {
+	kind  filterTokenType
+	value string
+	pos   int
+}
+
+// filterParser is a recursive descent parser of vtuber filter expression.
+//
+//	expr       = and { "OR" and }
+//	and        = not { "AND" not }
+//	not        = "NOT" not | "(" expr ")" | comparison
+//	comparison = field op value | field "IN" "(" value { "," value } ")"
+//
+// Keywords are case-insensitive and value containing
+// space or symbol should be double-quoted. For example,
+// `(agency_id:1 OR agency_id:2) AND NOT has_3d:true`.
+type filterParser struct {
+	tokens    []filterToken
+	pos       int
+	condition int
+}
+
+func (s *service) parseVtuberFilter(str string) (*entity.Filter, error) {
+	tokens, err := s.tokenizeFilter(str)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &filterParser{tokens: tokens}
+
+	filter, err := p.parseOr(1)
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind != filterTokenEOF {
+		return nil, errors.ErrInvalidFilter(fmt.Sprintf("unexpected %q at position %d", t.value, t.pos))
+	}
+
+	return filter, nil
+}
+
+func (s *service) tokenizeFilter(str string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(str)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case r == ' ' || r == '\t' || r == '\n':
+			i++
+
+		case r == '(' || r == ')' || r == ',' || r == ':' || r == '=' || r == '~':
+			tokens = append(tokens, filterToken{kind: filterTokenSymbol, value: string(r), pos: i})
+			i++
+
+		case r == '!' || r == '>' || r == '<':
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				tokens = append(tokens, filterToken{kind: filterTokenSymbol, value: string(runes[i : i+2]), pos: i})
+				i += 2
+				continue
+			}
+
+			if r == '!' {
+				return nil, errors.ErrInvalidFilter(fmt.Sprintf("unexpected %q at position %d", r, i))
+			}
+
+			tokens = append(tokens, filterToken{kind: filterTokenSymbol, value: string(r), pos: i})
+			i++
+
+		case r == '"':
+			var value strings.Builder
+			start := i
+			for i++; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				value.WriteRune(runes[i])
+			}
+
+			if i >= len(runes) {
+				return nil, errors.ErrInvalidFilter(fmt.Sprintf("unclosed quote at position %d", start))
+			}
+
+			tokens = append(tokens, filterToken{kind: filterTokenString, value: value.String(), pos: start})
+			i++
+
+		default:
+			start := i
+			for i < len(runes) && !strings.ContainsRune(" \t\n(),:=~!<>\"", runes[i]) {
+				i++
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenWord, value: string(runes[start:i]), pos: start})
+		}
+	}
+
+	return append(tokens, filterToken{kind: filterTokenEOF, pos: len(runes)}), nil
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() filterToken {
+	t := p.tokens[p.pos]
+	if t.kind != filterTokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *filterParser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == filterTokenWord && strings.EqualFold(t.value, keyword)
+}
+
+func (p *filterParser) isSymbol(symbol string) bool {
+	t := p.peek()
+	return t.kind == filterTokenSymbol && t.value == symbol
+}
+
+func (p *filterParser) expect(symbol string) error {
+	if t := p.next(); t.kind != filterTokenSymbol || t.value != symbol {
+		return p.unexpected(t)
+	}
+	return nil
+}
+
+func (p *filterParser) unexpected(t filterToken) error {
+	if t.kind == filterTokenEOF {
+		return errors.ErrInvalidFilter("unexpected end of expression")
+	}
+	return errors.ErrInvalidFilter(fmt.Sprintf("unexpected %q at position %d", t.value, t.pos))
+}
+
+func (p *filterParser) parseOr(depth int) (*entity.Filter, error) {
+	return p.parseLogical(depth, entity.FilterOr, p.parseAnd)
+}
+
+func (p *filterParser) parseAnd(depth int) (*entity.Filter, error) {
+	return p.parseLogical(depth, entity.FilterAnd, p.parseNot)
+}
+
+// parseLogical to parse operands joined by the same logical operator
+// into a single node, so "a AND b AND c" is not nested.
+func (p *filterParser) parseLogical(depth int, operator entity.FilterOperator, parseOperand func(int) (*entity.Filter, error)) (*entity.Filter, error) {
+	first, err := parseOperand(depth)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.isKeyword(string(operator)) {
+		return first, nil
+	}
+
+	filter := entity.Filter{Operator: operator, Children: []entity.Filter{*first}}
+	for p.isKeyword(string(operator)) {
+		p.next()
+
+		child, err := parseOperand(depth)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Children = append(filter.Children, *child)
+	}
+
+	return &filter, nil
+}
+
+// parseNot to parse negation, group, or comparison.
+// Depth is increased by each negation and group.
+func (p *filterParser) parseNot(depth int) (*entity.Filter, error) {
+	if depth > filterMaxDepth {
+		return nil, errors.ErrInvalidFilter(fmt.Sprintf("expression is nested more than %d levels", filterMaxDepth))
+	}
+
+	if p.isKeyword(string(entity.FilterNot)) {
+		p.next()
+
+		child, err := p.parseNot(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return &entity.Filter{Operator: entity.FilterNot, Children: []entity.Filter{*child}}, nil
+	}
+
+	if p.isSymbol("(") {
+		p.next()
+
+		filter, err := p.parseOr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+
+		return filter, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseComparison() (*entity.Filter, error) {
+	t := p.next()
+	if t.kind != filterTokenWord {
+		return nil, p.unexpected(t)
+	}
+
+	field := strings.ToLower(t.value)
+	fieldType, ok := filterFields[field]
+	if !ok {
+		return nil, errors.ErrInvalidFilter(fmt.Sprintf("unknown field %q", t.value))
+	}
+
+	p.condition++
+	if p.condition > filterMaxCondition {
+		return nil, errors.ErrInvalidFilter(fmt.Sprintf("more than %d conditions", filterMaxCondition))
+	}
+
+	var operator entity.FilterOperator
+	var values []interface{}
+
+	if p.isKeyword(string(entity.FilterIn)) {
+		p.next()
+		operator = entity.FilterIn
+
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+
+		for {
+			value, err := p.parseValue(field, fieldType)
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+			if len(values) > filterMaxValue {
+				return nil, errors.ErrInvalidFilter(fmt.Sprintf("more than %d values in %s", filterMaxValue, field))
+			}
+
+			if !p.isSymbol(",") {
+				break
+			}
+			p.next()
+		}
+
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+	} else {
+		t := p.next()
+		if operator, ok = filterSymbols[t.value]; !ok || t.kind != filterTokenSymbol {
+			return nil, p.unexpected(t)
+		}
+
+		value, err := p.parseValue(field, fieldType)
+		if err != nil {
+			return nil, err
+		}
+
+		values = []interface{}{value}
+	}
+
+	if !p.isOperatorAllowed(fieldType, operator) {
+		return nil, errors.ErrInvalidFilter(fmt.Sprintf("operator %s is not allowed for %s", operator, field))
+	}
+
+	return &entity.Filter{
+		Operator: operator,
+		Field:    field,
+		Values:   values,
+	}, nil
+}
+
+func (p *filterParser) parseValue(field string, fieldType filterFieldType) (interface{}, error) {
+	t := p.next()
+	if t.kind != filterTokenWord && t.kind != filterTokenString {
+		return nil, p.unexpected(t)
+	}
+
+	switch fieldType {
+	case filterNumber:
+		v, err := strconv.ParseInt(t.value, 10, 64)
+		if err != nil {
+			return nil, errors.ErrInvalidFilter(fmt.Sprintf("%s must be a number", field))
+		}
+		return v, nil
+
+	case filterBool:
+		v, err := strconv.ParseBool(t.value)
+		if err != nil {
+			return nil, errors.ErrInvalidFilter(fmt.Sprintf("%s must be true or false", field))
+		}
+		return v, nil
+
+	case filterDate:
+		v, err := time.Parse("2006-01-02", t.value)
+		if err != nil {
+			return nil, errors.ErrInvalidFilter(fmt.Sprintf("%s must be in 2006-01-02 format", field))
+		}
+		return v, nil
+
+	default:
+		return t.value, nil
+	}
+}
+
+func (p *filterParser) isOperatorAllowed(fieldType filterFieldType, operator entity.FilterOperator) bool {
+	for _, o := range filterOperators[fieldType] {
+		if o == operator {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+)
+
+func TestParseVtuberFilter(t *testing.T) {
+	cond := func(operator entity.FilterOperator, field string, values ...interface{}) entity.Filter {
+		return entity.Filter{Operator: operator, Field: field, Values: values}
+	}
+
+	logical := func(operator entity.FilterOperator, children ...entity.Filter) *entity.Filter {
+		return &entity.Filter{Operator: operator, Children: children}
+	}
+
+	single := func(f entity.Filter) *entity.Filter {
+		return &f
+	}
+
+	repeat := func(str, sep string, n int) string {
+		return strings.TrimSuffix(strings.Repeat(str+sep, n), sep)
+	}
+
+	tests := []struct {
+		name    string
+		str     string
+		want    *entity.Filter
+		wantErr bool
+	}{
+		{
+			name: "comparison",
+			str:  "agency_id:1",
+			want: single(cond(entity.FilterEq, "agency_id", int64(1))),
+		},
+		{
+			name: "case-insensitive",
+			str:  `NAME = "Usada Pekora" and Retired != false`,
+			want: logical(entity.FilterAnd,
+				cond(entity.FilterEq, "name", "Usada Pekora"),
+				cond(entity.FilterNe, "retired", false),
+			),
+		},
+		{
+			name: "flatten-same-operator",
+			str:  "has_2d:true AND has_3d:true AND retired:false",
+			want: logical(entity.FilterAnd,
+				cond(entity.FilterEq, "has_2d", true),
+				cond(entity.FilterEq, "has_3d", true),
+				cond(entity.FilterEq, "retired", false),
+			),
+		},
+		{
+			name: "and-before-or",
+			str:  "has_2d:true OR has_3d:true AND retired:false",
+			want: logical(entity.FilterOr,
+				cond(entity.FilterEq, "has_2d", true),
+				*logical(entity.FilterAnd,
+					cond(entity.FilterEq, "has_3d", true),
+					cond(entity.FilterEq, "retired", false),
+				),
+			),
+		},
+		{
+			name: "group-and-not",
+			str:  "(agency_id:1 OR agency_id:2) AND NOT has_3d:true",
+			want: logical(entity.FilterAnd,
+				*logical(entity.FilterOr,
+					cond(entity.FilterEq, "agency_id", int64(1)),
+					cond(entity.FilterEq, "agency_id", int64(2)),
+				),
+				*logical(entity.FilterNot, cond(entity.FilterEq, "has_3d", true)),
+			),
+		},
+		{
+			name: "in",
+			str:  "language_id IN (1, 2,3)",
+			want: single(cond(entity.FilterIn, "language_id", int64(1), int64(2), int64(3))),
+		},
+		{
+			name: "date",
+			str:  "debut_date>=2020-01-02",
+			want: single(cond(entity.FilterGte, "debut_date", time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))),
+		},
+		{
+			name: "contains",
+			str:  "name ~ peko",
+			want: single(cond(entity.FilterContains, "name", "peko")),
+		},
+		{
+			name: "escaped-quote",
+			str:  `name:"a\"b"`,
+			want: single(cond(entity.FilterEq, "name", `a"b`)),
+		},
+		{
+			name: "max-depth",
+			str:  repeat("NOT", " ", filterMaxDepth-1) + " has_2d:true",
+			want: logical(entity.FilterNot, *logical(entity.FilterNot, *logical(entity.FilterNot, *logical(entity.FilterNot, cond(entity.FilterEq, "has_2d", true))))),
+		},
+		{name: "empty", str: "", wantErr: true},
+		{name: "unknown-field", str: "foo:1", wantErr: true},
+		{name: "missing-value", str: "name:", wantErr: true},
+		{name: "missing-operator", str: "name peko", wantErr: true},
+		{name: "unclosed-quote", str: `name:"peko`, wantErr: true},
+		{name: "unclosed-group", str: "(name:peko", wantErr: true},
+		{name: "trailing-token", str: "name:peko )", wantErr: true},
+		{name: "lone-bang", str: "name!peko", wantErr: true},
+		{name: "invalid-number", str: "agency_id:abc", wantErr: true},
+		{name: "invalid-bool", str: "retired:maybe", wantErr: true},
+		{name: "invalid-date", str: "debut_date>2020/01/02", wantErr: true},
+		{name: "operator-not-allowed", str: "debut_date:2020-01-02", wantErr: true},
+		{name: "in-not-allowed", str: "retired IN (true)", wantErr: true},
+		{name: "too-deep", str: repeat("NOT", " ", filterMaxDepth) + " has_2d:true", wantErr: true},
+		{name: "too-many-conditions", str: repeat("has_2d:true", " OR ", filterMaxCondition+1), wantErr: true},
+		{name: "too-many-values", str: "agency_id IN (" + repeat("1", ",", filterMaxValue+1) + ")", wantErr: true},
+	}
+
+	s := &service{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.parseVtuberFilter(tt.str)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseVtuberFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("parseVtuberFilter() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
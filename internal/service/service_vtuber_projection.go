@@ -0,0 +1,122 @@
+package service
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/errors"
+)
+
+const videoLatestMax = 100
+
+// vtuberFields is list of vtuber response fields.
+const vtuberFields = "id name image original_names nicknames caption debut_date retirement_date has_2d has_3d character_designers character_2d_modelers character_3d_modelers agencies affiliations languages channels subscriber youtube_subscriber twitch_subscriber bilibili_subscriber niconico_subscriber total_subscriber monthly_subscriber video_count average_video_length total_video_length last_activity_date activity_status social_medias official_websites gender age birthday height weight blood_type zodiac_sign emoji updated_at"
+
+// MarshalJSON to marshal vtuber with only the selected fields.
+// ID is always included.
+func (v vtuber) MarshalJSON() ([]byte, error) {
+	type vtuberAlias vtuber
+
+	b, err := json.Marshal(vtuberAlias(v))
+	if err != nil || len(v.fields) == 0 {
+		return b, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(b, &all); err != nil {
+		return nil, err
+	}
+
+	res := map[string]json.RawMessage{"id": all["id"]}
+	for _, f := range v.fields {
+		if value, ok := all[f]; ok {
+			res[f] = value
+		}
+	}
+
+	return json.Marshal(res)
+}
+
+// getVtuberProjection to validate and convert fields and
+// video mode (none, latest:N, or all) to projection.
+func (s *service) getVtuberProjection(fields []string, videos string) (entity.Projection, error) {
+	projection := entity.Projection{Fields: fields}
+
+	for _, f := range fields {
+		if !slices.Contains(strings.Fields(vtuberFields), f) {
+			return projection, errors.ErrOneOfField("fields", vtuberFields)
+		}
+	}
+
+	mode, limit, _ := strings.Cut(videos, ":")
+	switch entity.VideoMode(mode) {
+	case entity.VideoModeAll, entity.VideoModeNone:
+		if limit != "" {
+			return projection, errors.ErrOneOfField("videos", "none latest:N all")
+		}
+		projection.VideoMode = entity.VideoMode(mode)
+
+	case entity.VideoModeLatest:
+		n, err := strconv.Atoi(limit)
+		if err != nil {
+			return projection, errors.ErrOneOfField("videos", "none latest:N all")
+		}
+
+		if n < 1 {
+			return projection, errors.ErrGTEField("videos", "latest:1")
+		}
+
+		if n > videoLatestMax {
+			return projection, errors.ErrLTEField("videos", "latest:"+strconv.Itoa(videoLatestMax))
+		}
+
+		projection.VideoMode = entity.VideoModeLatest
+		projection.VideoLimit = n
+
+	default:
+		return projection, errors.ErrOneOfField("videos", "none latest:N all")
+	}
+
+	return projection, nil
+}
+
+// isFullProjection to check if projection returns
+// all fields and all videos.
+func (s *service) isFullProjection(projection entity.Projection) bool {
+	return len(projection.Fields) == 0 && projection.VideoMode == entity.VideoModeAll
+}
+
+// applyVtuberProjection to limit channel videos of
+// vtuber which is not fetched with projection.
+func (s *service) applyVtuberProjection(vt entity.Vtuber, projection entity.Projection) entity.Vtuber {
+	if projection.VideoMode == entity.VideoModeAll {
+		return vt
+	}
+
+	channels := make([]entity.Channel, len(vt.Channels))
+	for i, c := range vt.Channels {
+		channels[i] = c
+
+		if projection.VideoMode == entity.VideoModeNone {
+			channels[i].Videos = nil
+			continue
+		}
+
+		videos := append([]entity.Video(nil), c.Videos...)
+		sort.SliceStable(videos, func(a, b int) bool {
+			if videos[a].StartDate == nil || videos[b].StartDate == nil {
+				return videos[b].StartDate == nil && videos[a].StartDate != nil
+			}
+			return videos[a].StartDate.After(*videos[b].StartDate)
+		})
+
+		channels[i].Videos = videos[:min(len(videos), projection.VideoLimit)]
+	}
+
+	vt.Channels = channels
+	return vt
+}
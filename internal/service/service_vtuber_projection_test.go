@@ -0,0 +1,44 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+)
+
+func TestGetVtuberProjection(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  []string
+		videos  string
+		want    entity.Projection
+		wantErr bool
+	}{
+		{name: "all", videos: "all", want: entity.Projection{VideoMode: entity.VideoModeAll}},
+		{name: "fields", fields: []string{"name", "updated_at"}, videos: "none", want: entity.Projection{Fields: []string{"name", "updated_at"}, VideoMode: entity.VideoModeNone}},
+		{name: "latest", videos: "latest:5", want: entity.Projection{VideoMode: entity.VideoModeLatest, VideoLimit: 5}},
+		{name: "invalid-field", fields: []string{"name", "password"}, videos: "all", wantErr: true},
+		{name: "empty-field", fields: []string{""}, videos: "all", wantErr: true},
+		{name: "invalid-videos", videos: "some", wantErr: true},
+		{name: "latest-over-max", videos: "latest:101", wantErr: true},
+	}
+
+	s := &service{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.getVtuberProjection(tt.fields, tt.videos)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getVtuberProjection() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("getVtuberProjection() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
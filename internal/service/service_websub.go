@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/rl404/fairy/errors/stack"
+	"github.com/rl404/shimakaze/internal/domain/vtuber/entity"
+	"github.com/rl404/shimakaze/internal/utils"
+)
+
+// VerifyYoutubeWebSubRequest is verify youtube websub request model.
+type VerifyYoutubeWebSubRequest struct {
+	Mode         string `validate:"required,oneof=subscribe unsubscribe denied" mod:"trim,lcase"`
+	Topic        string `validate:"required" mod:"trim"`
+	Challenge    string ``
+	LeaseSeconds int    `validate:"omitempty,gte=0"`
+}
+
+// VerifyYoutubeWebSub to verify youtube websub subscription intent.
+func (s *service) VerifyYoutubeWebSub(ctx context.Context, data VerifyYoutubeWebSubRequest) (string, int, error) {
+	if err := utils.Validate(&data); err != nil {
+		return "", http.StatusBadRequest, stack.Wrap(ctx, err)
+	}
+
+	channelID, code, err := s.websub.GetChannelIDByTopic(ctx, data.Topic)
+	if err != nil {
+		return "", code, stack.Wrap(ctx, err)
+	}
+
+	// Only confirm subscription for tracked channel.
+	if data.Mode == "subscribe" {
+		if _, code, err := s.vtuber.GetIDByChannelID(ctx, entity.ChannelYoutube, channelID); err != nil {
+			return "", code, stack.Wrap(ctx, err)
+		}
+	}
+
+	return data.Challenge, http.StatusOK, nil
+}
+
+// HandleYoutubeWebSub to handle youtube websub notification.
+func (s *service) HandleYoutubeWebSub(ctx context.Context, body []byte, signature string) (int, error) {
+	notifications, code, err := s.websub.ParseNotification(ctx, body, signature)
+	if err != nil {
+		if code == http.StatusUnauthorized {
+			// Hub expects 2xx even if the signature is invalid.
+			stack.Wrap(ctx, err)
+			return http.StatusOK, nil
+		}
+		return code, stack.Wrap(ctx, err)
+	}
+
+	channelMap := make(map[string]bool)
+	for _, n := range notifications {
+		if n.ChannelID == "" || channelMap[n.ChannelID] {
+			continue
+		}
+
+		channelMap[n.ChannelID] = true
+
+		vtuberID, code, err := s.vtuber.GetIDByChannelID(ctx, entity.ChannelYoutube, n.ChannelID)
+		if err != nil {
+			if code == http.StatusNotFound {
+				continue
+			}
+			return code, stack.Wrap(ctx, err)
+		}
+
+		if err := s.publisher.PublishRefreshChannel(ctx, vtuberID, n.ChannelID); err != nil {
+			return http.StatusInternalServerError, stack.Wrap(ctx, err)
+		}
+	}
+
+	return http.StatusOK, nil
+}
+
+// RenewYoutubeWebSub to renew youtube websub subscription.
+func (s *service) RenewYoutubeWebSub(ctx context.Context) (int, int, error) {
+	channelIDs, code, err := s.vtuber.GetActiveChannelIDs(ctx, entity.ChannelYoutube)
+	if err != nil {
+		return 0, code, stack.Wrap(ctx, err)
+	}
+
+	var cnt int
+	for _, channelID := range channelIDs {
+		if code, err := s.websub.Subscribe(ctx, channelID); err != nil {
+			return cnt, code, stack.Wrap(ctx, err)
+		}
+		cnt++
+	}
+
+	return cnt, http.StatusOK, nil
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/base64"
+
+	"github.com/rl404/shimakaze/internal/errors"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type cursor struct {
+	Sort   bson.Raw `bson:"s"`
+	Values bson.A   `bson:"v"`
+}
+
+// EncodeCursor to encode sort field values of the last
+// item as opaque cursor for the next page. The sort
+// should end with unique field so the cursor is stable.
+func EncodeCursor(sort bson.D, last bson.Raw) string {
+	sortRaw, err := bson.Marshal(sort)
+	if err != nil {
+		return ""
+	}
+
+	values := make(bson.A, len(sort))
+	for i, s := range sort {
+		if v, err := last.LookupErr(s.Key); err == nil {
+			values[i] = v
+		}
+	}
+
+	c, err := bson.Marshal(cursor{Sort: sortRaw, Values: values})
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(c)
+}
+
+// DecodeCursor to decode cursor to sort field values.
+// Cursor from different sort is invalid.
+func DecodeCursor(str string, sort bson.D) (bson.A, error) {
+	sortRaw, err := bson.Marshal(sort)
+	if err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(str)
+	if err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+
+	var c cursor
+	if err := bson.Unmarshal(raw, &c); err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+
+	if !bytes.Equal(c.Sort, sortRaw) || len(c.Values) != len(sort) {
+		return nil, errors.ErrInvalidCursor
+	}
+
+	return c.Values, nil
+}
+
+// GetCursorFilter to get filter of items after the cursor
+// sort field values. Null value is sorted first.
+func GetCursorFilter(sort bson.D, values bson.A) bson.M {
+	var filters bson.A
+	for i, s := range sort {
+		filter := bson.M{}
+		for j := 0; j < i; j++ {
+			filter[sort[j].Key] = values[j]
+		}
+
+		desc := s.Value == -1
+		switch {
+		case values[i] == nil && desc:
+			continue
+		case values[i] == nil:
+			filter[s.Key] = bson.M{"$ne": nil}
+		case desc:
+			// Include null which is sorted last.
+			filter[s.Key] = bson.M{"$not": bson.M{"$gte": values[i]}}
+		default:
+			filter[s.Key] = bson.M{"$gt": values[i]}
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return bson.M{"$or": filters}
+}
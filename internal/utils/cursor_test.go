@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rl404/shimakaze/internal/errors"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestEncodeDecodeCursor(t *testing.T) {
+	date := bson.NewDateTimeFromTime(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name string
+		sort bson.D
+		last bson.M
+		want bson.A
+	}{
+		{
+			name: "asc",
+			sort: bson.D{{Key: "name", Value: 1}, {Key: "id", Value: 1}},
+			last: bson.M{"name": "peko", "id": int64(3), "other": "x"},
+			want: bson.A{"peko", int64(3)},
+		},
+		{
+			name: "desc-date",
+			sort: bson.D{{Key: "debut_date", Value: -1}, {Key: "id", Value: 1}},
+			last: bson.M{"debut_date": date, "id": int64(3)},
+			want: bson.A{date, int64(3)},
+		},
+		{
+			name: "missing-field",
+			sort: bson.D{{Key: "debut_date", Value: -1}, {Key: "id", Value: 1}},
+			last: bson.M{"id": int32(5)},
+			want: bson.A{nil, int32(5)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			last, err := bson.Marshal(tt.last)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cursor := EncodeCursor(tt.sort, last)
+			if cursor == "" {
+				t.Fatal("EncodeCursor() is empty")
+			}
+
+			got, err := DecodeCursor(cursor, tt.sort)
+			if err != nil {
+				t.Fatalf("DecodeCursor() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("DecodeCursor() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	sort := bson.D{{Key: "name", Value: 1}, {Key: "id", Value: 1}}
+
+	last, err := bson.Marshal(bson.M{"name": "peko", "id": int64(3)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	valid := EncodeCursor(sort, last)
+
+	tests := []struct {
+		name   string
+		cursor string
+		sort   bson.D
+	}{
+		{name: "not-base64", cursor: "!!!", sort: sort},
+		{name: "not-bson", cursor: base64.RawURLEncoding.EncodeToString([]byte("peko")), sort: sort},
+		{name: "other-direction", cursor: valid, sort: bson.D{{Key: "name", Value: -1}, {Key: "id", Value: 1}}},
+		{name: "other-field", cursor: valid, sort: bson.D{{Key: "name", Value: 1}, {Key: "video_id", Value: 1}}},
+		{name: "fewer-field", cursor: valid, sort: bson.D{{Key: "name", Value: 1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeCursor(tt.cursor, tt.sort); err != errors.ErrInvalidCursor {
+				t.Fatalf("DecodeCursor() error = %v, want %v", err, errors.ErrInvalidCursor)
+			}
+		})
+	}
+}
+
+func TestGetCursorFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		sort   bson.D
+		values bson.A
+		want   bson.M
+	}{
+		{
+			name:   "asc",
+			sort:   bson.D{{Key: "name", Value: 1}, {Key: "id", Value: 1}},
+			values: bson.A{"peko", int64(3)},
+			want: bson.M{"$or": bson.A{
+				bson.M{"name": bson.M{"$gt": "peko"}},
+				bson.M{"name": "peko", "id": bson.M{"$gt": int64(3)}},
+			}},
+		},
+		{
+			name:   "desc",
+			sort:   bson.D{{Key: "subscriber", Value: -1}, {Key: "id", Value: 1}},
+			values: bson.A{int64(100), int64(3)},
+			want: bson.M{"$or": bson.A{
+				bson.M{"subscriber": bson.M{"$not": bson.M{"$gte": int64(100)}}},
+				bson.M{"subscriber": int64(100), "id": bson.M{"$gt": int64(3)}},
+			}},
+		},
+		{
+			name:   "asc-null",
+			sort:   bson.D{{Key: "debut_date", Value: 1}, {Key: "id", Value: 1}},
+			values: bson.A{nil, int64(3)},
+			want: bson.M{"$or": bson.A{
+				bson.M{"debut_date": bson.M{"$ne": nil}},
+				bson.M{"debut_date": nil, "id": bson.M{"$gt": int64(3)}},
+			}},
+		},
+		{
+			name:   "desc-null",
+			sort:   bson.D{{Key: "debut_date", Value: -1}, {Key: "id", Value: 1}},
+			values: bson.A{nil, int64(3)},
+			want: bson.M{"$or": bson.A{
+				bson.M{"debut_date": nil, "id": bson.M{"$gt": int64(3)}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetCursorFilter(tt.sort, tt.values); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("GetCursorFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
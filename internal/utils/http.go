@@ -61,6 +61,21 @@ func ResponseWithPNG(w http.ResponseWriter, code int, data []byte, err error) {
 	_, _ = w.Write(data)
 }
 
+// ResponseWithText to write response with plain text.
+func ResponseWithText(w http.ResponseWriter, code int, data string, err error) {
+	if err != nil {
+		ResponseWithJSON(w, code, nil, err)
+		return
+	}
+
+	// Set response header.
+	w.Header().Set("Content-Type", "text/plain")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(code)
+
+	_, _ = w.Write([]byte(data))
+}
+
 // HttpRecoverer is custom http recoverer middleware.
 // Will return 500.
 func HttpRecoverer(next http.Handler) http.Handler {
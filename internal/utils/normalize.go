@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var hepburnDigraphs = map[string]string{
+	"きゃ": "kya", "きゅ": "kyu", "きょ": "kyo",
+	"しゃ": "sha", "しゅ": "shu", "しょ": "sho", "しぇ": "she",
+	"ちゃ": "cha", "ちゅ": "chu", "ちょ": "cho", "ちぇ": "che",
+	"にゃ": "nya", "にゅ": "nyu", "にょ": "nyo",
+	"ひゃ": "hya", "ひゅ": "hyu", "ひょ": "hyo",
+	"みゃ": "mya", "みゅ": "myu", "みょ": "myo",
+	"りゃ": "rya", "りゅ": "ryu", "りょ": "ryo",
+	"ぎゃ": "gya", "ぎゅ": "gyu", "ぎょ": "gyo",
+	"じゃ": "ja", "じゅ": "ju", "じょ": "jo", "じぇ": "je",
+	"ぢゃ": "ja", "ぢゅ": "ju", "ぢょ": "jo",
+	"びゃ": "bya", "びゅ": "byu", "びょ": "byo",
+	"ぴゃ": "pya", "ぴゅ": "pyu", "ぴょ": "pyo",
+	"ふぁ": "fa", "ふぃ": "fi", "ふぇ": "fe", "ふぉ": "fo",
+	"てぃ": "ti", "でぃ": "di", "とぅ": "tu", "どぅ": "du",
+	"うぃ": "wi", "うぇ": "we", "うぉ": "wo",
+	"ゔぁ": "va", "ゔぃ": "vi", "ゔぇ": "ve", "ゔぉ": "vo",
+	"つぁ": "tsa", "つぃ": "tsi", "つぇ": "tse", "つぉ": "tso",
+}
+
+var hepburnMonographs = map[rune]string{
+	'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o",
+	'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko",
+	'さ': "sa", 'し': "shi", 'す': "su", 'せ': "se", 'そ': "so",
+	'た': "ta", 'ち': "chi", 'つ': "tsu", 'て': "te", 'と': "to",
+	'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no",
+	'は': "ha", 'ひ': "hi", 'ふ': "fu", 'へ': "he", 'ほ': "ho",
+	'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo",
+	'や': "ya", 'ゆ': "yu", 'よ': "yo",
+	'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro",
+	'わ': "wa", 'ゐ': "i", 'ゑ': "e", 'を': "o", 'ん': "n",
+	'が': "ga", 'ぎ': "gi", 'ぐ': "gu", 'げ': "ge", 'ご': "go",
+	'ざ': "za", 'じ': "ji", 'ず': "zu", 'ぜ': "ze", 'ぞ': "zo",
+	'だ': "da", 'ぢ': "ji", 'づ': "zu", 'で': "de", 'ど': "do",
+	'ば': "ba", 'び': "bi", 'ぶ': "bu", 'べ': "be", 'ぼ': "bo",
+	'ぱ': "pa", 'ぴ': "pi", 'ぷ': "pu", 'ぺ': "pe", 'ぽ': "po",
+	'ゔ': "vu",
+	'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o",
+	'ゃ': "ya", 'ゅ': "yu", 'ょ': "yo", 'ゎ': "wa",
+}
+
+// NormalizeSearchText to normalize text for searching.
+//
+// Full-width and half-width characters are folded (NFKC),
+// letters are lowercased, and hiragana & katakana are
+// romanized with simplified Hepburn, so "ﾍﾟｺﾗ", "ペコラ",
+// "ぺこら", and "Pekora" produce the same text. Kanji is
+// separated from adjacent non-kanji letters with a space.
+func NormalizeSearchText(str string) string {
+	runes := []rune(strings.ToLower(norm.NFKC.String(str)))
+	for i, r := range runes {
+		runes[i] = katakanaToHiragana(r)
+	}
+
+	var res strings.Builder
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		// Long vowel mark is dropped.
+		if r == 'ー' {
+			continue
+		}
+
+		if i > 0 && isScriptBoundary(runes[i-1], r) {
+			res.WriteRune(' ')
+		}
+
+		if !isHiragana(r) {
+			res.WriteRune(r)
+			continue
+		}
+
+		// Sokuon doubles the next consonant.
+		if r == 'っ' {
+			if i+1 < len(runes) {
+				if next := romanizeKana(runes[i+1:]); next != "" {
+					if strings.HasPrefix(next, "ch") {
+						res.WriteByte('t')
+					} else if !strings.ContainsRune("aiueon", rune(next[0])) {
+						res.WriteByte(next[0])
+					}
+				}
+			}
+			continue
+		}
+
+		romaji := romanizeKana(runes[i:])
+		if _, ok := hepburnDigraphs[string(runes[i:min(i+2, len(runes))])]; ok {
+			i++
+		}
+
+		res.WriteString(romaji)
+	}
+
+	return res.String()
+}
+
+// isScriptBoundary to check if 2 adjacent letters are
+// kanji and non-kanji, so they can be searched separately.
+func isScriptBoundary(prev, curr rune) bool {
+	if !unicode.IsLetter(prev) || !unicode.IsLetter(curr) {
+		return false
+	}
+	return unicode.Is(unicode.Han, prev) != unicode.Is(unicode.Han, curr)
+}
+
+// romanizeKana to romanize the first kana (or kana digraph).
+func romanizeKana(runes []rune) string {
+	if len(runes) >= 2 {
+		if romaji, ok := hepburnDigraphs[string(runes[:2])]; ok {
+			return romaji
+		}
+	}
+	return hepburnMonographs[runes[0]]
+}
+
+// katakanaToHiragana to convert katakana to hiragana.
+// Small ヵ and ヶ are read as full-size か and け (e.g. 一ヶ月).
+func katakanaToHiragana(r rune) rune {
+	switch r {
+	case 'ヵ':
+		return 'か'
+	case 'ヶ':
+		return 'け'
+	}
+	if r >= 'ァ' && r <= 'ヴ' {
+		return r - 0x60
+	}
+	return r
+}
+
+func isHiragana(r rune) bool {
+	return r >= 'ぁ' && r <= 'ゖ'
+}
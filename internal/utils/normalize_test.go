@@ -0,0 +1,38 @@
+package utils
+
+import "testing"
+
+func TestNormalizeSearchText(t *testing.T) {
+	tests := []struct {
+		name string
+		str  string
+		want string
+	}{
+		{name: "empty", str: "", want: ""},
+		{name: "latin", str: "Pekora", want: "pekora"},
+		{name: "punctuation", str: "Hello World!", want: "hello world!"},
+		{name: "full-width", str: "ＡＢＣ１２３", want: "abc123"},
+		{name: "half-width-katakana", str: "ﾍﾟｺﾗ", want: "pekora"},
+		{name: "katakana", str: "ペコラ", want: "pekora"},
+		{name: "hiragana", str: "ぺこら", want: "pekora"},
+		{name: "digraph", str: "きょう", want: "kyou"},
+		{name: "foreign-digraph", str: "ヴァ", want: "va"},
+		{name: "long-vowel", str: "シャーク", want: "shaku"},
+		{name: "sokuon", str: "がっこう", want: "gakkou"},
+		{name: "sokuon-ch", str: "まっちゃ", want: "matcha"},
+		{name: "sokuon-last", str: "っ", want: ""},
+		{name: "n", str: "ん", want: "n"},
+		{name: "small-ka-ke", str: "ヵヶ", want: "kake"},
+		{name: "space", str: "ほしまち すいせい", want: "hoshimachi suisei"},
+		{name: "kanji-boundary", str: "兎田ぺこら", want: "兎田 pekora"},
+		{name: "kanji-katakana-boundary", str: "宝鐘マリン", want: "宝鐘 marin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeSearchText(tt.str); got != tt.want {
+				t.Fatalf("NormalizeSearchText(%q) = %q, want %q", tt.str, got, tt.want)
+			}
+		})
+	}
+}
@@ -110,6 +110,21 @@ func StrToStrSlice(str string) []string {
 	return strings.Split(str, ",")
 }
 
+// StrToInt64Slice to split comma separated string to int64 slice.
+// Invalid number will be 0.
+func StrToInt64Slice(str string) []int64 {
+	strs := StrToStrSlice(str)
+	if strs == nil {
+		return nil
+	}
+
+	ints := make([]int64, len(strs))
+	for i, s := range strs {
+		ints[i], _ = strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	}
+	return ints
+}
+
 // GetLastPathFromURL to get the last path from url.
 func GetLastPathFromURL(str string) string {
 	url, err := url.Parse(str)
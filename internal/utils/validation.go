@@ -16,6 +16,7 @@ func init() {
 	val = playground.New(true)
 	val.RegisterModifier("no_space", modNoSpace)
 	val.RegisterValidatorError("required", valErrRequired)
+	val.RegisterValidatorError("required_with", valErrRequired)
 	val.RegisterValidatorError("gte", valErrGTE)
 	val.RegisterValidatorError("gt", valErrGT)
 	val.RegisterValidatorError("lte", valErrLTE)
@@ -0,0 +1,245 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rl404/fairy/cache"
+)
+
+// State is circuit breaker state.
+type State string
+
+// Available circuit breaker states.
+const (
+	StateClosed   State = "closed"
+	StateOpen     State = "open"
+	StateHalfOpen State = "half-open"
+)
+
+// Available circuit breaker errors.
+var (
+	ErrOpen   = errors.New("circuit breaker is open")
+	ErrLocked = errors.New("circuit breaker state is locked")
+)
+
+const (
+	lockTTL   = 5 * time.Second
+	lockRetry = 20
+	lockWait  = 10 * time.Millisecond
+)
+
+// EndpointState is state of an endpoint.
+type EndpointState struct {
+	Endpoint string     `json:"endpoint"`
+	State    State      `json:"state"`
+	Failure  int        `json:"failure"`
+	OpenedAt *time.Time `json:"opened_at"`
+}
+
+// Breaker is circuit breaker with state per endpoint.
+//
+// The state is stored in cache so it can be
+// shared between processes using the same cache.
+// Without cache, the state is kept in-process.
+// The locker guards the state update and makes
+// sure only one trial call is let through when
+// the breaker is half-open.
+type Breaker struct {
+	cacher    cache.Cacher
+	locker    Locker
+	name      string
+	key       string
+	threshold int
+	timeout   time.Duration
+
+	local          map[string]endpointState
+	localExpiredAt time.Time
+	localMu        sync.Mutex
+}
+
+type endpointState struct {
+	Failure  int
+	OpenedAt *time.Time
+}
+
+// New to create new circuit breaker.
+//
+// The breaker will open after threshold consecutive
+// failures and stay open for timeout duration before
+// letting a trial call through. Use nil cacher if the
+// cache does not keep data (e.g. no-operation cache).
+func New(cacher cache.Cacher, locker Locker, name string, threshold int, timeout time.Duration) *Breaker {
+	return &Breaker{
+		cacher:    cacher,
+		locker:    locker,
+		name:      name,
+		key:       "breaker:" + name,
+		threshold: threshold,
+		timeout:   timeout,
+	}
+}
+
+// Name to get breaker name.
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// Do to call fn if the endpoint breaker is not open.
+// Server errors and too many requests are counted
+// as failures.
+func (b *Breaker) Do(ctx context.Context, endpoint string, fn func() (int, error)) (int, error) {
+	switch b.getState(b.getEndpoints(ctx)[endpoint]) {
+	case StateOpen:
+		return http.StatusServiceUnavailable, ErrOpen
+	case StateHalfOpen:
+		// Only the probe token owner can try the call.
+		key := b.key + ":probe:" + endpoint
+		token, ok, err := b.locker.Lock(ctx, key, b.timeout)
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+
+		if !ok {
+			return http.StatusServiceUnavailable, ErrOpen
+		}
+
+		defer b.locker.Unlock(ctx, key, token)
+	}
+
+	code, err := fn()
+
+	if recordErr := b.record(ctx, endpoint, code < http.StatusInternalServerError && code != http.StatusTooManyRequests); recordErr != nil && err == nil {
+		return http.StatusInternalServerError, recordErr
+	}
+
+	return code, err
+}
+
+// GetStates to get all endpoint states.
+func (b *Breaker) GetStates(ctx context.Context) []EndpointState {
+	endpoints := b.getEndpoints(ctx)
+
+	res := make([]EndpointState, 0, len(endpoints))
+	for endpoint, state := range endpoints {
+		res = append(res, EndpointState{
+			Endpoint: endpoint,
+			State:    b.getState(state),
+			Failure:  state.Failure,
+			OpenedAt: state.OpenedAt,
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Endpoint < res[j].Endpoint
+	})
+
+	return res
+}
+
+func (b *Breaker) record(ctx context.Context, endpoint string, success bool) error {
+	// Nothing to update.
+	if success && b.getEndpoints(ctx)[endpoint].Failure == 0 {
+		return nil
+	}
+
+	token, err := b.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer b.locker.Unlock(ctx, b.key+":lock", token)
+
+	// Read again after locked.
+	endpoints := b.getEndpoints(ctx)
+	state := endpoints[endpoint]
+
+	if success {
+		if state.Failure == 0 {
+			return nil
+		}
+		state = endpointState{}
+	} else {
+		state.Failure++
+		if state.Failure >= b.threshold || b.getState(state) == StateHalfOpen {
+			now := time.Now()
+			state.OpenedAt = &now
+		}
+	}
+
+	endpoints[endpoint] = state
+
+	return b.setEndpoints(ctx, endpoints)
+}
+
+func (b *Breaker) lock(ctx context.Context) (string, error) {
+	for range lockRetry {
+		token, ok, err := b.locker.Lock(ctx, b.key+":lock", lockTTL)
+		if err != nil {
+			return "", err
+		}
+
+		if ok {
+			return token, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(lockWait):
+		}
+	}
+
+	return "", ErrLocked
+}
+
+func (b *Breaker) getEndpoints(ctx context.Context) map[string]endpointState {
+	if b.cacher == nil {
+		b.localMu.Lock()
+		defer b.localMu.Unlock()
+
+		endpoints := make(map[string]endpointState)
+		if time.Now().Before(b.localExpiredAt) {
+			for k, v := range b.local {
+				endpoints[k] = v
+			}
+		}
+		return endpoints
+	}
+
+	var endpoints map[string]endpointState
+	if b.cacher.Get(ctx, b.key, &endpoints) != nil || endpoints == nil {
+		return make(map[string]endpointState)
+	}
+	return endpoints
+}
+
+// setEndpoints to save endpoint states. The states
+// expire like cache so old failures are forgotten.
+func (b *Breaker) setEndpoints(ctx context.Context, endpoints map[string]endpointState) error {
+	if b.cacher == nil {
+		b.localMu.Lock()
+		defer b.localMu.Unlock()
+
+		b.local = endpoints
+		b.localExpiredAt = time.Now().Add(2 * b.timeout)
+		return nil
+	}
+
+	return b.cacher.Set(ctx, b.key, endpoints, 2*b.timeout)
+}
+
+func (b *Breaker) getState(state endpointState) State {
+	if state.OpenedAt == nil {
+		return StateClosed
+	}
+
+	if time.Since(*state.OpenedAt) < b.timeout {
+		return StateOpen
+	}
+
+	return StateHalfOpen
+}
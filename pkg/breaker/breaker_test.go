@@ -0,0 +1,177 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rl404/fairy/cache/inmemory"
+	"github.com/rl404/fairy/cache/nop"
+)
+
+const testTimeout = 50 * time.Millisecond
+
+func newTestBreakers(t *testing.T) map[string]*Breaker {
+	c, err := inmemory.New(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return map[string]*Breaker{
+		"cache": New(c, NewLocalLocker(), "test", 2, testTimeout),
+		"local": New(nil, NewLocalLocker(), "test", 2, testTimeout),
+	}
+}
+
+func call(b *Breaker, endpoint string, code int) (bool, error) {
+	var called bool
+	_, err := b.Do(context.Background(), endpoint, func() (int, error) {
+		called = true
+		if code >= http.StatusBadRequest {
+			return code, errors.New(http.StatusText(code))
+		}
+		return code, nil
+	})
+	return called, err
+}
+
+func getState(b *Breaker, endpoint string) State {
+	for _, s := range b.GetStates(context.Background()) {
+		if s.Endpoint == endpoint {
+			return s.State
+		}
+	}
+	return StateClosed
+}
+
+func TestBreakerOpen(t *testing.T) {
+	for name, b := range newTestBreakers(t) {
+		t.Run(name, func(t *testing.T) {
+			// Client error is not a platform failure.
+			call(b, "a", http.StatusNotFound)
+			call(b, "a", http.StatusNotFound)
+			if s := getState(b, "a"); s != StateClosed {
+				t.Fatalf("state after client errors = %s, want %s", s, StateClosed)
+			}
+
+			// Success resets consecutive failures.
+			call(b, "a", http.StatusInternalServerError)
+			call(b, "a", http.StatusOK)
+			call(b, "a", http.StatusTooManyRequests)
+			if s := getState(b, "a"); s != StateClosed {
+				t.Fatalf("state after reset = %s, want %s", s, StateClosed)
+			}
+
+			call(b, "a", http.StatusBadGateway)
+			if s := getState(b, "a"); s != StateOpen {
+				t.Fatalf("state after threshold = %s, want %s", s, StateOpen)
+			}
+
+			if called, err := call(b, "a", http.StatusOK); called || !errors.Is(err, ErrOpen) {
+				t.Fatalf("open call = %v %v, want not called and %v", called, err, ErrOpen)
+			}
+
+			// Other endpoint is not affected.
+			if called, err := call(b, "b", http.StatusOK); !called || err != nil {
+				t.Fatalf("other endpoint call = %v %v, want called", called, err)
+			}
+		})
+	}
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	for name, b := range newTestBreakers(t) {
+		t.Run(name, func(t *testing.T) {
+			call(b, "a", http.StatusInternalServerError)
+			call(b, "a", http.StatusInternalServerError)
+
+			time.Sleep(testTimeout)
+			if s := getState(b, "a"); s != StateHalfOpen {
+				t.Fatalf("state after timeout = %s, want %s", s, StateHalfOpen)
+			}
+
+			// Only one probe while the first is running.
+			probing := make(chan struct{})
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				b.Do(context.Background(), "a", func() (int, error) {
+					close(probing)
+					time.Sleep(testTimeout / 2)
+					return http.StatusServiceUnavailable, errors.New("unavailable")
+				})
+			}()
+
+			<-probing
+			if called, err := call(b, "a", http.StatusOK); called || !errors.Is(err, ErrOpen) {
+				t.Fatalf("second probe = %v %v, want not called and %v", called, err, ErrOpen)
+			}
+			<-done
+
+			// Failed probe opens it again.
+			if s := getState(b, "a"); s != StateOpen {
+				t.Fatalf("state after failed probe = %s, want %s", s, StateOpen)
+			}
+
+			time.Sleep(testTimeout)
+			if called, err := call(b, "a", http.StatusOK); !called || err != nil {
+				t.Fatalf("probe = %v %v, want called", called, err)
+			}
+
+			if s := getState(b, "a"); s != StateClosed {
+				t.Fatalf("state after success probe = %s, want %s", s, StateClosed)
+			}
+		})
+	}
+}
+
+func TestBreakerNoCache(t *testing.T) {
+	nopCache, _ := nop.New()
+	withNop := New(nopCache, NewLocalLocker(), "test", 1, time.Minute)
+	withoutCache := New(nil, NewLocalLocker(), "test", 1, time.Minute)
+
+	call(withNop, "a", http.StatusInternalServerError)
+	call(withoutCache, "a", http.StatusInternalServerError)
+
+	// No-operation cache forgets the state so it never opens.
+	if s := getState(withNop, "a"); s != StateClosed {
+		t.Fatalf("no-operation cache state = %s, want %s", s, StateClosed)
+	}
+
+	if s := getState(withoutCache, "a"); s != StateOpen {
+		t.Fatalf("in-process state = %s, want %s", s, StateOpen)
+	}
+}
+
+func TestLocalLocker(t *testing.T) {
+	ctx := context.Background()
+	l := NewLocalLocker()
+
+	token, ok, _ := l.Lock(ctx, "key", testTimeout)
+	if !ok {
+		t.Fatal("first lock is not acquired")
+	}
+
+	if _, ok, _ := l.Lock(ctx, "key", testTimeout); ok {
+		t.Fatal("second lock is acquired")
+	}
+
+	// Wrong token does not release the lock.
+	l.Unlock(ctx, "key", "other")
+	if _, ok, _ := l.Lock(ctx, "key", testTimeout); ok {
+		t.Fatal("lock is released by wrong token")
+	}
+
+	l.Unlock(ctx, "key", token)
+	if _, ok, _ := l.Lock(ctx, "key", testTimeout); !ok {
+		t.Fatal("lock is not released")
+	}
+
+	// Expired lock can be acquired again.
+	time.Sleep(testTimeout)
+	if _, ok, _ := l.Lock(ctx, "key", testTimeout); !ok {
+		t.Fatal("expired lock is not acquired")
+	}
+}
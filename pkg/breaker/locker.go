@@ -0,0 +1,104 @@
+package breaker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Locker is lock to guard breaker state.
+//
+// Use lock which can be shared between processes
+// if the breaker state cache is shared too.
+type Locker interface {
+	// Lock to acquire the key. Return false if the
+	// key is already acquired. The returned token is
+	// needed to release the key.
+	Lock(ctx context.Context, key string, ttl time.Duration) (string, bool, error)
+	// Unlock to release the key acquired with the token.
+	Unlock(ctx context.Context, key, token string) error
+}
+
+type localLock struct {
+	token     string
+	expiredAt time.Time
+}
+
+// LocalLocker is in-process locker.
+type LocalLocker struct {
+	locks map[string]localLock
+	mu    sync.Mutex
+}
+
+// NewLocalLocker to create new in-process locker.
+func NewLocalLocker() *LocalLocker {
+	return &LocalLocker{
+		locks: make(map[string]localLock),
+	}
+}
+
+// Lock to acquire the key.
+func (l *LocalLocker) Lock(_ context.Context, key string, ttl time.Duration) (string, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if lock, ok := l.locks[key]; ok && time.Now().Before(lock.expiredAt) {
+		return "", false, nil
+	}
+
+	token := uuid.NewString()
+	l.locks[key] = localLock{token: token, expiredAt: time.Now().Add(ttl)}
+
+	return token, true, nil
+}
+
+// Unlock to release the key.
+func (l *LocalLocker) Unlock(_ context.Context, key, token string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.locks[key].token == token {
+		delete(l.locks, key)
+	}
+
+	return nil
+}
+
+// Only delete the key if it is still owned by the token.
+var redisUnlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// RedisLocker is locker shared between processes using redis.
+type RedisLocker struct {
+	client *redis.Client
+}
+
+// NewRedisLocker to create new redis locker.
+func NewRedisLocker(client *redis.Client) *RedisLocker {
+	return &RedisLocker{
+		client: client,
+	}
+}
+
+// Lock to acquire the key.
+func (l *RedisLocker) Lock(ctx context.Context, key string, ttl time.Duration) (string, bool, error) {
+	token := uuid.NewString()
+
+	ok, err := l.client.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return "", false, err
+	}
+
+	return token, ok, nil
+}
+
+// Unlock to release the key.
+func (l *RedisLocker) Unlock(ctx context.Context, key, token string) error {
+	return redisUnlockScript.Run(ctx, l.client, []string{key}, token).Err()
+}
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rl404/fairy/cache"
+)
+
+// MultiGetter is cacher which can get multiple data at once.
+type MultiGetter interface {
+	// GetMultiRaw to get raw JSON data of the keys in
+	// the same order. Data of missing keys is nil.
+	GetMultiRaw(ctx context.Context, keys []string) ([][]byte, error)
+}
+
+// GetMulti to get data of multiple keys in one call if the
+// cacher supports it, or one key at a time if not. Data of
+// missing keys is nil.
+func GetMulti[T any](ctx context.Context, cacher cache.Cacher, keys []string) []*T {
+	res := make([]*T, len(keys))
+
+	if m, ok := cacher.(MultiGetter); ok {
+		if raws, err := m.GetMultiRaw(ctx, keys); err == nil {
+			for i, raw := range raws {
+				if raw != nil && json.Unmarshal(raw, &res[i]) != nil {
+					res[i] = nil
+				}
+			}
+			return res
+		}
+	}
+
+	for i, key := range keys {
+		if cacher.Get(ctx, key, &res[i]) != nil {
+			res[i] = nil
+		}
+	}
+
+	return res
+}
+
+type redisMultiGetter struct {
+	cache.Cacher
+	client *redis.Client
+}
+
+// WithRedisMultiGet to add batched get to redis cacher
+// using MGET. The client should connect to the same
+// redis as the cacher.
+func WithRedisMultiGet(cacher cache.Cacher, client *redis.Client) cache.Cacher {
+	return &redisMultiGetter{
+		Cacher: cacher,
+		client: client,
+	}
+}
+
+// GetMultiRaw to get raw JSON data of the keys.
+func (r *redisMultiGetter) GetMultiRaw(ctx context.Context, keys []string) ([][]byte, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([][]byte, len(values))
+	for i, v := range values {
+		if str, ok := v.(string); ok {
+			res[i] = []byte(str)
+		}
+	}
+
+	return res, nil
+}
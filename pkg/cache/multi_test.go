@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rl404/fairy/cache"
+	"github.com/rl404/fairy/cache/inmemory"
+)
+
+type data struct {
+	Name string
+}
+
+type multiGetter struct {
+	cache.Cacher
+	calls int
+	raws  [][]byte
+	err   error
+}
+
+func (m *multiGetter) GetMultiRaw(ctx context.Context, keys []string) ([][]byte, error) {
+	m.calls++
+	return m.raws, m.err
+}
+
+func TestGetMulti(t *testing.T) {
+	ctx := context.Background()
+
+	c, err := inmemory.New(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Set(ctx, "a", data{Name: "a"}); err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(t *testing.T, got []*data, want []string) {
+		if len(got) != len(want) {
+			t.Fatalf("GetMulti() len = %d, want %d", len(got), len(want))
+		}
+		for i := range want {
+			if (got[i] == nil) != (want[i] == "") || (got[i] != nil && got[i].Name != want[i]) {
+				t.Fatalf("GetMulti()[%d] = %+v, want %q", i, got[i], want[i])
+			}
+		}
+	}
+
+	t.Run("one-by-one", func(t *testing.T) {
+		check(t, GetMulti[data](ctx, c, []string{"a", "b"}), []string{"a", ""})
+	})
+
+	t.Run("batched", func(t *testing.T) {
+		m := &multiGetter{Cacher: c, raws: [][]byte{nil, []byte(`{"Name":"b"}`), []byte(`invalid`)}}
+		check(t, GetMulti[data](ctx, m, []string{"a", "b", "c"}), []string{"", "b", ""})
+		if m.calls != 1 {
+			t.Fatalf("GetMultiRaw() calls = %d, want 1", m.calls)
+		}
+	})
+
+	t.Run("batched-error-fallback", func(t *testing.T) {
+		m := &multiGetter{Cacher: c, err: errors.New("error")}
+		check(t, GetMulti[data](ctx, m, []string{"a", "b"}), []string{"a", ""})
+	})
+}